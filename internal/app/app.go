@@ -14,13 +14,17 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/willibrandon/steep/internal/alerts"
 	"github.com/willibrandon/steep/internal/config"
+	"github.com/willibrandon/steep/internal/cluster"
 	"github.com/willibrandon/steep/internal/db"
 	"github.com/willibrandon/steep/internal/db/queries"
+	"github.com/willibrandon/steep/internal/jobs"
 	"github.com/willibrandon/steep/internal/logger"
 	"github.com/willibrandon/steep/internal/metrics"
 	"github.com/willibrandon/steep/internal/monitors"
 	querymonitor "github.com/willibrandon/steep/internal/monitors/queries"
+	"github.com/willibrandon/steep/internal/notify"
 	"github.com/willibrandon/steep/internal/storage/sqlite"
+	"github.com/willibrandon/steep/internal/tracing"
 	"github.com/willibrandon/steep/internal/ui"
 	"github.com/willibrandon/steep/internal/ui/components"
 	"github.com/willibrandon/steep/internal/ui/components/vimtea"
@@ -37,6 +41,16 @@ import (
 	tablesview "github.com/willibrandon/steep/internal/ui/views/tables"
 )
 
+const (
+	// poolResetPressureThreshold is the acquire-pressure (see
+	// db.PoolPressure) above which the pool is considered to be carrying
+	// stale connections worth resetting proactively.
+	poolResetPressureThreshold = 0.5
+	// poolResetCooldown limits how often a proactive pool.Reset() can fire,
+	// so a sustained high-pressure period doesn't reset on every tick.
+	poolResetCooldown = time.Minute
+)
+
 // Model represents the main Bubbletea application model
 type Model struct {
 	// Configuration
@@ -90,6 +104,12 @@ type Model struct {
 	reconnectionState *db.ReconnectionState
 	reconnecting      bool
 
+	// Connection pool health, backing the "Pool Health" panel and adaptive
+	// reconnection backoff (see db.ReconnectionState.RecordPoolPressure)
+	lastPoolStats    *pgxpool.Stat
+	lastPoolPressure float64 // db.PoolPressure trend as of the last poll
+	lastPoolReset    time.Time
+
 	// Status bar data
 	statusTimestamp   time.Time
 	activeConnections int
@@ -119,6 +139,20 @@ type Model struct {
 	metricsStore      *sqlite.MetricsStore
 	connectionMetrics *metrics.ConnectionMetrics
 
+	// Background maintenance job queue
+	jobQueue   *jobs.Queue
+	jobManager *jobs.Manager
+
+	// Multi-instance cluster registry for fan-out queries (T054)
+	clusterRegistry *cluster.Registry
+
+	// Query tracer backing the "Live Queries" panel (nil unless enabled)
+	queryTracer *tracing.Tracer
+
+	// LISTEN/NOTIFY bridge backing the "Notifications" panel (nil unless
+	// cfg.Notify.Enabled and at least one channel is configured)
+	notifyBridge *notify.Bridge
+
 	// Chart visibility (global toggle)
 	chartsVisible bool
 
@@ -238,11 +272,25 @@ func (m *Model) MetricsCollector() *metrics.Collector {
 	return m.metricsCollector
 }
 
+// instanceDisplayInfo snapshots the cluster registry into the status bar's
+// display representation.
+func (m *Model) instanceDisplayInfo() []components.InstanceDisplayInfo {
+	if m.clusterRegistry == nil {
+		return nil
+	}
+	snapshot := m.clusterRegistry.Snapshot()
+	info := make([]components.InstanceDisplayInfo, len(snapshot))
+	for i, inst := range snapshot {
+		info[i] = components.InstanceDisplayInfo{Name: inst.Name, Status: string(inst.Status)}
+	}
+	return info
+}
+
 // Init initializes the application
 func (m Model) Init() tea.Cmd {
 	logger.Debug("app: Init called - starting up")
 	return tea.Batch(
-		connectToDatabase(m.config),
+		connectToDatabase(m.config, m.program),
 		tickStatusBar(),
 		m.locksView.Init(),
 		m.queriesView.Init(),
@@ -344,6 +392,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.dbPool = msg.Pool
 		m.serverVersion = msg.Version
 		m.connectionErr = nil
+		m.queryTracer = msg.Tracer
 		m.statusBar.SetConnected(true)
 		m.dashboard.SetConnected(true)
 		m.dashboard.SetServerVersion(msg.Version)
@@ -474,6 +523,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.replicationMonitor = monitors.NewReplicationMonitor(msg.Pool, 2*time.Second, nil)
 		}
 
+		// Initialize background maintenance job queue
+		m.jobQueue = jobs.NewQueue(msg.Pool)
+		m.jobManager = jobs.NewManager(m.jobQueue, "steep-tui",
+			jobs.WithEventHandler(func(ev jobs.Event) {
+				if m.program != nil {
+					m.program.Send(jobMsgForEvent(ev))
+				}
+			}),
+		)
+		jobs.RegisterMaintenanceHandlers(m.jobManager, msg.Pool)
+
+		// Seed any config-defined recurring maintenance jobs. Each seeded
+		// job re-enqueues itself on completion via its Every interval (see
+		// jobs.Queue.Complete), giving the queue a cron-style scheduler;
+		// EnsureRecurring is idempotent so reconnects don't pile up
+		// duplicate rows.
+		var jobSeedCmds []tea.Cmd
+		if m.config.Jobs.Enabled {
+			jobSeedCmds = seedScheduledJobs(m.jobQueue, m.config.Jobs.Schedule)
+		}
+
+		// Register this connection as the cluster's primary instance; the
+		// rest of the cluster (replicas/related clusters) connects once cmds
+		// below is built.
+		m.clusterRegistry = cluster.NewRegistry()
+		m.clusterRegistry.Register("primary", msg.Pool)
+		m.statusBar.SetInstances([]components.InstanceDisplayInfo{
+			{Name: "primary", Status: "connected"},
+		})
+
 		// Get our own PIDs for self-kill warning
 		go func() {
 			ctx := context.Background()
@@ -503,7 +582,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			fetchReplicationData(m.replicationMonitor),
 			fetchDeadlockHistory(m.deadlockMonitor, m.program),
 			fetchConfigData(m.configMonitor),
+			runJobManager(m.jobQueue, m.jobManager, m.program),
 		}
+		cmds = append(cmds, jobSeedCmds...)
 		// Only fetch tables data when Tables view is active (pgstattuple is expensive)
 		if m.currentView == views.ViewTables {
 			cmds = append(cmds, m.tablesView.FetchTablesData())
@@ -523,12 +604,34 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		// Check logging status for logs view
 		cmds = append(cmds, checkLogsLoggingStatus(msg.Pool, m.config.Logs))
+
+		// Connect any configured replicas/related clusters and start
+		// periodic health checks across the whole registry.
+		for _, inst := range m.config.Agent.Instances {
+			cmds = append(cmds, connectClusterInstance(m.clusterRegistry, inst.Name, inst.Connection))
+		}
+		cmds = append(cmds, clusterHealthTick(m.clusterRegistry, m.program, clusterHealthInterval))
+		cmds = append(cmds, fetchClusterOverview(m.clusterRegistry, clusterOverviewInterval))
+
+		// Poll connection pool health for the "Pool Health" panel.
+		cmds = append(cmds, pollPoolStats(msg.Pool, m.config.UI.RefreshInterval))
+
+		// Start the LISTEN/NOTIFY bridge for the "Notifications" panel.
+		if m.config.Notify.Enabled && len(m.config.Notify.Channels) > 0 {
+			m.notifyBridge = notify.NewBridge(msg.Pool, m.config.Notify.Channels, m.config.Notify.BufferSize)
+			cmds = append(cmds, runNotifyBridge(m.notifyBridge, m.program))
+		}
+
 		return m, tea.Batch(cmds...)
 
 	case ConnectionFailedMsg:
 		m.connected = false
 		m.connectionErr = msg.Err
 		m.statusBar.SetConnected(false)
+		// Feed the failure's error code and the pool's last-known acquire
+		// pressure into the adaptive backoff calculation.
+		m.reconnectionState.RecordFailure(msg.Err)
+		m.reconnectionState.RecordPoolPressure(m.lastPoolPressure)
 		// Trigger reconnection
 		if !m.reconnecting {
 			m.reconnecting = true
@@ -536,6 +639,21 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case PoolStatsMsg:
+		m.lastPoolPressure = db.PoolPressure(m.lastPoolStats, msg.Stat)
+		m.lastPoolStats = msg.Stat
+		m.dashboard.RecordPoolStats(msg.Stat)
+		if m.dbPool != nil && m.lastPoolPressure > poolResetPressureThreshold &&
+			time.Since(m.lastPoolReset) > poolResetCooldown {
+			m.lastPoolReset = time.Now()
+			logger.Warn("pool under sustained acquire pressure, resetting stale connections",
+				"canceled_acquires", msg.Stat.CanceledAcquireCount(),
+				"empty_acquires", msg.Stat.EmptyAcquireCount(),
+			)
+			m.dbPool.Reset()
+		}
+		return m, pollPoolStats(m.dbPool, m.config.UI.RefreshInterval)
+
 	case StatusBarTickMsg:
 		m.statusTimestamp = msg.Timestamp
 		m.statusBar.SetTimestamp(msg.Timestamp)
@@ -546,6 +664,60 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// This message is no longer used for status bar updates
 		return m, nil
 
+	case JobEnqueuedMsg:
+		m.dashboard.RecordJobEvent(jobs.Event{Kind: jobs.EventEnqueued, Job: msg.Job})
+		return m, nil
+
+	case JobStartedMsg:
+		m.dashboard.RecordJobEvent(jobs.Event{Kind: jobs.EventStarted, Job: msg.Job})
+		return m, nil
+
+	case JobProgressMsg:
+		m.dashboard.RecordJobEvent(jobs.Event{Kind: jobs.EventProgress, Job: msg.Job, Percent: msg.Percent})
+		return m, nil
+
+	case JobCompletedMsg:
+		m.dashboard.RecordJobEvent(jobs.Event{Kind: jobs.EventCompleted, Job: msg.Job})
+		return m, nil
+
+	case JobFailedMsg:
+		m.dashboard.RecordJobEvent(jobs.Event{Kind: jobs.EventFailed, Job: msg.Job, Err: msg.Err})
+		return m, nil
+
+	case InstanceConnectedMsg:
+		m.statusBar.SetInstances(m.instanceDisplayInfo())
+		return m, nil
+
+	case InstanceConnectionFailedMsg:
+		logger.Warn("failed to connect cluster instance", "instance", msg.Name, "error", msg.Err)
+		return m, nil
+
+	case InstanceDisconnectedMsg:
+		logger.Warn("cluster instance became unreachable", "instance", msg.Name, "error", msg.Err)
+		m.statusBar.SetInstances(m.instanceDisplayInfo())
+		return m, nil
+
+	case InstanceHealthMsg:
+		m.statusBar.SetInstances(m.instanceDisplayInfo())
+		return m, clusterHealthTick(m.clusterRegistry, m.program, clusterHealthInterval)
+
+	case ClusterOverviewMsg:
+		m.dashboard.RecordClusterOverview(msg.Results)
+		return m, fetchClusterOverview(m.clusterRegistry, clusterOverviewInterval)
+
+	case QueryTraceMsg:
+		m.dashboard.RecordQueryTrace(msg.Trace)
+		return m, nil
+
+	case PgNotificationMsg:
+		m.dashboard.RecordNotification(notify.Notification{
+			Channel:   msg.Channel,
+			Payload:   msg.Payload,
+			PID:       msg.PID,
+			Timestamp: msg.Timestamp,
+		})
+		return m, nil
+
 	case ui.ActivityDataMsg:
 		// Update connection metrics for sparklines
 		if m.connectionMetrics != nil && msg.Connections != nil {
@@ -1162,7 +1334,29 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.statusBar.SetReconnecting(false, 0, 0)
 		m.dashboard.SetConnected(true)
 		m.dashboard.SetServerVersion(msg.Version)
-		return m, nil
+
+		// Pool stats polling already follows m.dbPool (just updated above) via
+		// its own self-reschedule in the PoolStatsMsg handler, so it resumes
+		// against the new pool on its own; starting a second pollPoolStats
+		// loop here would just accumulate one extra perpetual poller per
+		// reconnect.
+		var cmds []tea.Cmd
+
+		// Re-LISTEN on the fresh connection pool. Reuse the existing bridge
+		// (if any) rather than constructing a new one, so its already-running
+		// forwarding goroutine keeps draining Notifications instead of
+		// leaking a second one pointed at an abandoned channel.
+		if m.config.Notify.Enabled && len(m.config.Notify.Channels) > 0 {
+			if m.notifyBridge != nil {
+				m.notifyBridge.SetPool(msg.Pool)
+				cmds = append(cmds, relistenNotifyBridge(m.notifyBridge))
+			} else {
+				m.notifyBridge = notify.NewBridge(msg.Pool, m.config.Notify.Channels, m.config.Notify.BufferSize)
+				cmds = append(cmds, runNotifyBridge(m.notifyBridge, m.program))
+			}
+		}
+
+		return m, tea.Batch(cmds...)
 
 	case ReconnectFailedMsg:
 		m.reconnecting = false
@@ -1523,6 +1717,9 @@ func (m *Model) Cleanup() {
 	if m.queryMonitor != nil {
 		m.queryMonitor.Stop()
 	}
+	if m.notifyBridge != nil {
+		m.notifyBridge.Stop()
+	}
 	if m.steepDB != nil {
 		m.steepDB.Close()
 	}
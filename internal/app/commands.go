@@ -8,24 +8,42 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/willibrandon/steep/internal/cluster"
 	"github.com/willibrandon/steep/internal/config"
 	"github.com/willibrandon/steep/internal/db"
 	"github.com/willibrandon/steep/internal/db/queries"
+	"github.com/willibrandon/steep/internal/jobs"
 	"github.com/willibrandon/steep/internal/logger"
 	"github.com/willibrandon/steep/internal/monitors"
 	querymonitor "github.com/willibrandon/steep/internal/monitors/queries"
+	"github.com/willibrandon/steep/internal/notify"
 	"github.com/willibrandon/steep/internal/storage/sqlite"
+	"github.com/willibrandon/steep/internal/tracing"
 	"github.com/willibrandon/steep/internal/ui"
 	locksview "github.com/willibrandon/steep/internal/ui/views/locks"
 	logsview "github.com/willibrandon/steep/internal/ui/views/logs"
 	queriesview "github.com/willibrandon/steep/internal/ui/views/queries"
 )
 
-// connectToDatabase creates a command to connect to the database
-func connectToDatabase(cfg *config.Config) tea.Cmd {
+// connectToDatabase creates a command to connect to the database. If query
+// tracing is enabled, it installs a tracing.Tracer on the pool that pushes
+// QueryTraceMsg for every sampled operation.
+func connectToDatabase(cfg *config.Config, program *tea.Program) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
-		pool, err := db.NewConnectionPool(ctx, cfg)
+
+		var opts []db.PoolOption
+		var tracer *tracing.Tracer
+		if cfg.Tracing.Enabled {
+			tracer = tracing.New(cfg.Tracing.BufferSize, cfg.Tracing.SampleRate, func(t tracing.Trace) {
+				if program != nil {
+					program.Send(QueryTraceMsg{Trace: t})
+				}
+			})
+			opts = append(opts, db.WithTracer(tracer))
+		}
+
+		pool, err := db.NewConnectionPool(ctx, cfg, opts...)
 		if err != nil {
 			return ConnectionFailedMsg{Err: err}
 		}
@@ -39,10 +57,90 @@ func connectToDatabase(cfg *config.Config) tea.Cmd {
 		return DatabaseConnectedMsg{
 			Pool:    pool,
 			Version: version,
+			Tracer:  tracer,
+		}
+	}
+}
+
+// connectClusterInstance creates a command to connect one additional
+// instance (replica or related cluster member) configured under
+// cfg.Agent.Instances, registering it in registry on success.
+func connectClusterInstance(registry *cluster.Registry, name, dsn string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		pool, err := cluster.Connect(ctx, dsn)
+		if err != nil {
+			return InstanceConnectionFailedMsg{Name: name, Err: err}
 		}
+		registry.Register(name, pool)
+		return InstanceConnectedMsg{Name: name, Pool: pool}
 	}
 }
 
+// clusterHealthInterval is how often clusterHealthTick re-checks every
+// registered instance's reachability.
+const clusterHealthInterval = 30 * time.Second
+
+// clusterHealthTick creates a command that checks every registered
+// instance's reachability and reports the results as InstanceHealthMsg,
+// degrading any newly-unreachable instance to InstanceDisconnectedMsg first.
+// Callers should re-invoke this from the InstanceHealthMsg handler so the
+// check keeps recurring rather than firing once.
+func clusterHealthTick(registry *cluster.Registry, program *tea.Program, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		ctx := context.Background()
+		for _, inst := range registry.Snapshot() {
+			if err := cluster.HealthCheck(ctx, inst.Pool); err != nil {
+				if inst.Status != cluster.StatusError {
+					registry.MarkUnreachable(inst.Name, err)
+					if program != nil {
+						program.Send(InstanceDisconnectedMsg{Name: inst.Name, Err: err})
+					}
+				}
+				continue
+			}
+			registry.MarkHealthy(inst.Name)
+		}
+		return InstanceHealthMsg{Instances: registry.Snapshot()}
+	})
+}
+
+// clusterOverviewQuery is fanned out to every registered instance for the
+// "Cluster Overview" panel: per-database backend/commit/cache-hit counts
+// that are cheap enough to poll on every instance without adding load.
+const clusterOverviewQuery = `
+	SELECT numbackends, xact_commit, xact_rollback, blks_hit, blks_read
+	FROM pg_stat_database
+	WHERE datname = current_database()
+`
+
+// clusterOverviewTimeout bounds how long any single instance's query may
+// take before it degrades to an error entry for that instance.
+const clusterOverviewTimeout = 3 * time.Second
+
+// clusterOverviewInterval is how often the "Cluster Overview" panel's
+// fan-out query is re-run.
+const clusterOverviewInterval = 5 * time.Second
+
+// fetchClusterOverview creates a command that runs clusterOverviewQuery
+// against every registered cluster instance in parallel after interval,
+// for the "Cluster Overview" panel's per-instance columns.
+func fetchClusterOverview(registry *cluster.Registry, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		ctx := context.Background()
+		results := registry.QueryAll(ctx, clusterOverviewTimeout, clusterOverviewQuery)
+		return ClusterOverviewMsg{Results: results}
+	})
+}
+
+// pollPoolStats creates a command that polls the connection pool's health
+// via pgxpool.Stat() after interval, for the "Pool Health" panel.
+func pollPoolStats(pool *pgxpool.Pool, interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
+		return PoolStatsMsg{Stat: pool.Stat()}
+	})
+}
+
 // tickStatusBar creates a command to update the status bar timestamp
 func tickStatusBar() tea.Cmd {
 	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
@@ -59,7 +157,7 @@ func attemptReconnection(cfg *config.Config, state *db.ReconnectionState) tea.Cm
 		attemptMsg := ReconnectAttemptMsg{
 			Attempt:     state.Attempt + 1,
 			MaxAttempts: state.MaxAttempts,
-			NextDelay:   state.CalculateNextDelay(),
+			NextDelay:   state.CalculateAdaptiveNextDelay(),
 		}
 
 		// Attempt reconnection
@@ -425,6 +523,117 @@ func fetchDeadlockDetail(store *sqlite.DeadlockStore, eventID int64) tea.Cmd {
 	}
 }
 
+// jobMsgForEvent converts a jobs.Event into the corresponding app-level
+// message so it can be pushed through the Bubble Tea update loop.
+func jobMsgForEvent(ev jobs.Event) tea.Msg {
+	switch ev.Kind {
+	case jobs.EventEnqueued:
+		return JobEnqueuedMsg{Job: ev.Job}
+	case jobs.EventStarted:
+		return JobStartedMsg{Job: ev.Job}
+	case jobs.EventProgress:
+		return JobProgressMsg{Job: ev.Job, Percent: ev.Percent}
+	case jobs.EventCompleted:
+		return JobCompletedMsg{Job: ev.Job}
+	default:
+		return JobFailedMsg{Job: ev.Job, Err: ev.Err}
+	}
+}
+
+// runJobManager creates a command that ensures the steep_jobs schema exists
+// and then runs the job manager until the program exits, forwarding its
+// lifecycle events to the Bubble Tea update loop via program.Send.
+func runJobManager(queue *jobs.Queue, manager *jobs.Manager, program *tea.Program) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		if err := queue.EnsureSchema(ctx); err != nil {
+			logger.Debug("failed to ensure steep_jobs schema", "error", err)
+			return nil
+		}
+		manager.Run(ctx)
+		return nil
+	}
+}
+
+// seedScheduledJobs returns one command per entry in schedule that seeds a
+// recurring maintenance job via Queue.EnsureRecurring, giving the job queue
+// its cron-style scheduler: each job re-enqueues itself on completion (see
+// Queue.Complete), and EnsureRecurring's idempotent check means calling this
+// again on every reconnect never piles up duplicate rows. Entries with an
+// unrecognized kind are skipped with a debug log rather than failing the
+// whole batch.
+func seedScheduledJobs(queue *jobs.Queue, schedule []config.ScheduledJob) []tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(schedule))
+	for _, sched := range schedule {
+		sched := sched
+		var args any
+		switch sched.Kind {
+		case jobs.KindVacuum:
+			args = jobs.VacuumArgs{Schema: sched.Schema, Table: sched.Table}
+		case jobs.KindReindex:
+			args = jobs.ReindexArgs{Schema: sched.Schema, Index: sched.Table}
+		default:
+			logger.Debug("skipping scheduled job with unrecognized kind", "kind", sched.Kind)
+			continue
+		}
+
+		cmds = append(cmds, func() tea.Msg {
+			ctx := context.Background()
+			job, created, err := queue.EnsureRecurring(ctx, sched.Kind, args, jobs.EnqueueOptions{Every: sched.Every})
+			if err != nil {
+				logger.Debug("failed to seed scheduled job", "kind", sched.Kind, "error", err)
+				return nil
+			}
+			if !created {
+				return nil
+			}
+			return JobEnqueuedMsg{Job: job}
+		})
+	}
+	return cmds
+}
+
+// runNotifyBridge creates a command that forwards the bridge's notifications
+// to the Bubble Tea update loop via program.Send, then LISTENs until the
+// dedicated connection is lost (e.g. on a reconnect). It is called once per
+// bridge; after a successful reconnection, relistenNotifyBridge resumes
+// listening on the new pool.
+func runNotifyBridge(bridge *notify.Bridge, program *tea.Program) tea.Cmd {
+	return func() tea.Msg {
+		go func() {
+			for n := range bridge.Notifications() {
+				if program != nil {
+					program.Send(PgNotificationMsg{
+						Channel:   n.Channel,
+						Payload:   n.Payload,
+						PID:       n.PID,
+						Timestamp: n.Timestamp,
+					})
+				}
+			}
+		}()
+
+		if err := bridge.Listen(context.Background()); err != nil {
+			logger.Debug("notify bridge stopped listening", "error", err)
+		}
+		return nil
+	}
+}
+
+// relistenNotifyBridge creates a command that resumes bridge.Listen after a
+// reconnect has pointed it at a fresh pool via Bridge.SetPool. Unlike
+// runNotifyBridge, it does not spawn a second forwarding goroutine over
+// Notifications — the one started by the original runNotifyBridge call
+// keeps draining the same bridge.
+func relistenNotifyBridge(bridge *notify.Bridge) tea.Cmd {
+	return func() tea.Msg {
+		if err := bridge.Listen(context.Background()); err != nil {
+			logger.Debug("notify bridge stopped listening", "error", err)
+		}
+		return nil
+	}
+}
+
 // enableLoggingCollector creates a command to enable logging_collector
 func enableLoggingCollector(pool *pgxpool.Pool) tea.Cmd {
 	return func() tea.Msg {
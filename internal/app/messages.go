@@ -4,12 +4,16 @@ import (
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/willibrandon/steep/internal/cluster"
+	"github.com/willibrandon/steep/internal/jobs"
+	"github.com/willibrandon/steep/internal/tracing"
 )
 
 // DatabaseConnectedMsg is sent when the database connection is successfully established
 type DatabaseConnectedMsg struct {
 	Pool    *pgxpool.Pool
 	Version string
+	Tracer  *tracing.Tracer // nil unless cfg.Tracing.Enabled
 }
 
 // ConnectionFailedMsg is sent when the database connection fails
@@ -63,5 +67,74 @@ type InstanceConnectionFailedMsg struct {
 	Err  error
 }
 
+// InstanceDisconnectedMsg is sent when a previously-connected instance fails
+// a health check, so the cluster fan-out degrades gracefully instead of
+// blocking on it.
+type InstanceDisconnectedMsg struct {
+	Name string
+	Err  error
+}
+
+// InstanceHealthMsg is sent after each cluster health-check tick with the
+// current status of every registered instance.
+type InstanceHealthMsg struct {
+	Instances []cluster.Instance
+}
+
+// ClusterOverviewMsg is sent after each fan-out query tick with every
+// registered instance's row (or error), for the "Cluster Overview" panel
+// that renders per-instance columns side by side.
+type ClusterOverviewMsg struct {
+	Results map[string]cluster.RowsOrErr
+}
+
+// QueryTraceMsg is sent for each sampled pgx operation recorded by the
+// query tracer (see internal/tracing), for the "Live Queries" panel.
+type QueryTraceMsg struct {
+	Trace tracing.Trace
+}
+
+// PoolStatsMsg is sent on each poll of the connection pool's health, exposing
+// the full pgxpool.Stat surface for the "Pool Health" panel.
+type PoolStatsMsg struct {
+	Stat *pgxpool.Stat
+}
+
+// PgNotificationMsg is sent for each Postgres NOTIFY received on a LISTENed
+// channel (see internal/notify), for the "Notifications" panel.
+type PgNotificationMsg struct {
+	Channel   string
+	Payload   string
+	PID       uint32
+	Timestamp time.Time
+}
+
 // dataTickMsg triggers synchronized fetch of all data
 type dataTickMsg struct{}
+
+// JobEnqueuedMsg is sent when a maintenance job is added to the queue.
+type JobEnqueuedMsg struct {
+	Job jobs.Job
+}
+
+// JobStartedMsg is sent when a worker claims and begins running a job.
+type JobStartedMsg struct {
+	Job jobs.Job
+}
+
+// JobProgressMsg is sent as a running job reports incremental progress.
+type JobProgressMsg struct {
+	Job     jobs.Job
+	Percent float64
+}
+
+// JobCompletedMsg is sent when a job finishes successfully.
+type JobCompletedMsg struct {
+	Job jobs.Job
+}
+
+// JobFailedMsg is sent when a job fails, whether or not it will be retried.
+type JobFailedMsg struct {
+	Job jobs.Job
+	Err error
+}
@@ -0,0 +1,177 @@
+// Package cluster maintains a registry of connected PostgreSQL instance pools
+// (a primary plus any replicas or related clusters configured via
+// config.AgentInstanceConfig) and provides a fan-out query API that runs a
+// query against every registered instance in parallel. It lets TUI panels
+// render side-by-side per-instance columns instead of being pinned to a
+// single connection.
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Status describes the reachability of a registered instance.
+type Status string
+
+const (
+	StatusConnected    Status = "connected"
+	StatusDisconnected Status = "disconnected"
+	StatusError        Status = "error"
+)
+
+// Instance is a single registered pool plus its health status.
+type Instance struct {
+	Name   string
+	Pool   *pgxpool.Pool
+	Status Status
+	Err    error
+}
+
+// Registry holds the set of instance pools queries fan out to.
+type Registry struct {
+	mu        sync.RWMutex
+	instances map[string]*Instance
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{instances: make(map[string]*Instance)}
+}
+
+// Register adds or replaces the pool for name, marking it connected.
+func (r *Registry) Register(name string, pool *pgxpool.Pool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.instances[name] = &Instance{Name: name, Pool: pool, Status: StatusConnected}
+}
+
+// Unregister removes name from the registry, closing nothing (callers own
+// the pool's lifecycle).
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.instances, name)
+}
+
+// MarkUnreachable records that name failed a health check without removing
+// it from the registry, so it can recover on a later check.
+func (r *Registry) MarkUnreachable(name string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if inst, ok := r.instances[name]; ok {
+		inst.Status = StatusError
+		inst.Err = err
+	}
+}
+
+// MarkHealthy clears a prior error status for name.
+func (r *Registry) MarkHealthy(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if inst, ok := r.instances[name]; ok {
+		inst.Status = StatusConnected
+		inst.Err = nil
+	}
+}
+
+// Get returns the pool registered under name.
+func (r *Registry) Get(name string) (*pgxpool.Pool, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	inst, ok := r.instances[name]
+	if !ok {
+		return nil, false
+	}
+	return inst.Pool, true
+}
+
+// Snapshot returns a point-in-time copy of every registered instance's
+// status, ordered by name.
+func (r *Registry) Snapshot() []Instance {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]Instance, 0, len(r.instances))
+	for _, inst := range r.instances {
+		out = append(out, *inst)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Row is a single result row, keyed by column name.
+type Row map[string]any
+
+// RowsOrErr is one instance's result from QueryAll: either the rows the
+// query returned there, or the error that prevented it from completing.
+type RowsOrErr struct {
+	Rows []Row
+	Err  error
+}
+
+// QueryAll runs sql against every registered instance in parallel, bounding
+// each instance's execution with timeout. A slow or failing instance
+// degrades to an error entry in the result map rather than blocking or
+// failing the call for the others. Instances already marked unreachable by
+// MarkUnreachable are not queried and have no entry in the result map.
+func (r *Registry) QueryAll(ctx context.Context, timeout time.Duration, sql string, args ...any) map[string]RowsOrErr {
+	r.mu.RLock()
+	targets := make(map[string]*pgxpool.Pool, len(r.instances))
+	for name, inst := range r.instances {
+		if inst.Status != StatusError {
+			targets[name] = inst.Pool
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make(map[string]RowsOrErr, len(targets))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, pool := range targets {
+		wg.Add(1)
+		go func(name string, pool *pgxpool.Pool) {
+			defer wg.Done()
+			rows, err := queryRows(ctx, pool, timeout, sql, args...)
+			mu.Lock()
+			results[name] = RowsOrErr{Rows: rows, Err: err}
+			mu.Unlock()
+		}(name, pool)
+	}
+	wg.Wait()
+	return results
+}
+
+func queryRows(ctx context.Context, pool *pgxpool.Pool, timeout time.Duration, sql string, args ...any) ([]Row, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	rows, err := pool.Query(queryCtx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	defer rows.Close()
+
+	fieldDescs := rows.FieldDescriptions()
+	var result []Row
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, fmt.Errorf("read row values: %w", err)
+		}
+		row := make(Row, len(fieldDescs))
+		for i, fd := range fieldDescs {
+			row[string(fd.Name)] = values[i]
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration: %w", err)
+	}
+	return result, nil
+}
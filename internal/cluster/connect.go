@@ -0,0 +1,46 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// connectTimeout bounds how long a single instance connection attempt may take.
+const connectTimeout = 30 * time.Second
+
+// Connect opens a small pool against dsn and verifies it with a ping. It is
+// used to bring up the replica/related-cluster pools named in
+// config.AgentInstanceConfig, independently of the primary connection the
+// rest of the app uses.
+func Connect(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connection string: %w", err)
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+
+	pool, err := pgxpool.NewWithConfig(connectCtx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+
+	if err := pool.Ping(connectCtx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("ping: %w", err)
+	}
+
+	return pool, nil
+}
+
+// HealthCheck pings pool with a short timeout, returning the error (if any)
+// that should be surfaced to callers as a degraded/unreachable instance.
+func HealthCheck(ctx context.Context, pool *pgxpool.Pool) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	return pool.Ping(ctx)
+}
@@ -18,6 +18,9 @@ type Config struct {
 	Logs        LogsConfig        `mapstructure:"logs"`
 	Alerts      AlertsConfig      `mapstructure:"alerts"`
 	Agent       AgentConfig       `mapstructure:"agent"`
+	Tracing     TracingConfig     `mapstructure:"tracing"`
+	Notify      NotifyConfig      `mapstructure:"notify"`
+	Jobs        JobsConfig        `mapstructure:"jobs"`
 	Debug       bool              `mapstructure:"debug"`
 	LogFile     string            `mapstructure:"log_file"`
 }
@@ -142,6 +145,9 @@ func createDefaultConfig() (*Config, error) {
 			Rules:            []AlertRuleConfig{},
 		},
 		Agent:   DefaultAgentConfig(),
+		Tracing: DefaultTracingConfig(),
+		Notify:  DefaultNotifyConfig(),
+		Jobs:    DefaultJobsConfig(),
 		Debug:   viper.GetBool("debug"),
 		LogFile: viper.GetString("log_file"),
 	}
@@ -365,4 +371,18 @@ func applyDefaults() {
 	viper.SetDefault("agent.retention.metrics", "24h")
 	viper.SetDefault("agent.alerts.enabled", false)
 	viper.SetDefault("agent.alerts.webhook_url", "")
+
+	// Tracing defaults
+	viper.SetDefault("tracing.enabled", false)
+	viper.SetDefault("tracing.sample_rate", 1.0)
+	viper.SetDefault("tracing.buffer_size", 500)
+
+	// Notify (LISTEN/NOTIFY bridge) defaults
+	viper.SetDefault("notify.enabled", false)
+	viper.SetDefault("notify.channels", []string{})
+	viper.SetDefault("notify.buffer_size", 200)
+
+	// Jobs (background maintenance queue) defaults
+	viper.SetDefault("jobs.enabled", false)
+	viper.SetDefault("jobs.schedule", []ScheduledJob{})
 }
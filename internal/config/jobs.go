@@ -0,0 +1,37 @@
+package config
+
+import "time"
+
+// ScheduledJob describes a recurring maintenance job to seed into the
+// steep_jobs queue on connect (see jobs.Queue.EnsureRecurring). Kind must
+// match one of the jobs.Kind* constants.
+type ScheduledJob struct {
+	// Kind selects the handler, e.g. "vacuum" or "reindex".
+	Kind string `mapstructure:"kind"`
+
+	// Schema is the target schema.
+	Schema string `mapstructure:"schema"`
+
+	// Table is the target table (vacuum) or index (reindex).
+	Table string `mapstructure:"table"`
+
+	// Every is how often the job re-enqueues itself after completing.
+	Every time.Duration `mapstructure:"every"`
+}
+
+// JobsConfig holds configuration for the background maintenance job queue.
+type JobsConfig struct {
+	// Enabled indicates whether the job queue subsystem starts at all.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Schedule is the set of recurring maintenance jobs seeded on connect.
+	Schedule []ScheduledJob `mapstructure:"schedule"`
+}
+
+// DefaultJobsConfig returns default job queue configuration.
+func DefaultJobsConfig() JobsConfig {
+	return JobsConfig{
+		Enabled:  false,
+		Schedule: nil,
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+// NotifyConfig holds configuration for the LISTEN/NOTIFY event bridge that
+// backs the "Notifications" panel.
+type NotifyConfig struct {
+	// Enabled indicates whether the bridge acquires a dedicated connection
+	// and listens for notifications.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Channels is the set of Postgres channels to LISTEN on.
+	Channels []string `mapstructure:"channels"`
+
+	// BufferSize is the number of recent notifications kept in memory.
+	BufferSize int `mapstructure:"buffer_size"`
+}
+
+// DefaultNotifyConfig returns default LISTEN/NOTIFY bridge configuration.
+func DefaultNotifyConfig() NotifyConfig {
+	return NotifyConfig{
+		Enabled:    false,
+		Channels:   nil,
+		BufferSize: 200,
+	}
+}
@@ -0,0 +1,24 @@
+package config
+
+// TracingConfig holds configuration for the pgx query tracer that backs the
+// "Live Queries" panel.
+type TracingConfig struct {
+	// Enabled indicates whether the pgx tracer is installed on the pool.
+	Enabled bool `mapstructure:"enabled"`
+
+	// SampleRate is the fraction (0..1) of operations to record. 1 traces
+	// every query; lower values reduce overhead on busy connections.
+	SampleRate float64 `mapstructure:"sample_rate"`
+
+	// BufferSize is the number of recent traces kept in memory.
+	BufferSize int `mapstructure:"buffer_size"`
+}
+
+// DefaultTracingConfig returns default query tracing configuration.
+func DefaultTracingConfig() TracingConfig {
+	return TracingConfig{
+		Enabled:    false,
+		SampleRate: 1.0,
+		BufferSize: 500,
+	}
+}
@@ -5,13 +5,27 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/willibrandon/steep/internal/config"
 	"github.com/willibrandon/steep/internal/logger"
 )
 
+// PoolOption customizes the pgxpool.Config used by NewConnectionPool before
+// the pool is created.
+type PoolOption func(*pgxpool.Config)
+
+// WithTracer installs tracer on the pool's connections. tracer typically
+// implements pgx.QueryTracer plus any of pgx.BatchTracer, pgx.ConnectTracer,
+// pgx.PrepareTracer, and pgx.CopyFromTracer (see internal/tracing).
+func WithTracer(tracer pgx.QueryTracer) PoolOption {
+	return func(cfg *pgxpool.Config) {
+		cfg.ConnConfig.Tracer = tracer
+	}
+}
+
 // NewConnectionPool creates a new PostgreSQL connection pool using the provided configuration
-func NewConnectionPool(ctx context.Context, cfg *config.Config) (*pgxpool.Pool, error) {
+func NewConnectionPool(ctx context.Context, cfg *config.Config, opts ...PoolOption) (*pgxpool.Pool, error) {
 	logger.Debug("Creating new database connection pool",
 		"host", cfg.Connection.Host,
 		"port", cfg.Connection.Port,
@@ -65,6 +79,10 @@ func NewConnectionPool(ctx context.Context, cfg *config.Config) (*pgxpool.Pool,
 	poolConfig.HealthCheckPeriod = time.Minute
 	poolConfig.ConnConfig.RuntimeParams["application_name"] = "steep"
 
+	for _, opt := range opts {
+		opt(poolConfig)
+	}
+
 	logger.Debug("Connection pool configuration",
 		"max_conns", cfg.Connection.PoolMaxConns,
 		"min_conns", cfg.Connection.PoolMinConns,
@@ -2,20 +2,39 @@ package db
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/willibrandon/steep/internal/config"
 	"github.com/willibrandon/steep/internal/logger"
 )
 
+// Postgres error codes that get special-cased by CalculateAdaptiveNextDelay.
+const (
+	// pgCannotConnectNow is raised while the server is still starting up or
+	// shedding connections - a transient condition worth retrying fast.
+	pgCannotConnectNow = "57P03"
+	// pgInvalidPassword indicates an auth failure that a fast retry cannot
+	// fix, so back off hard instead of hammering the server.
+	pgInvalidPassword = "28P01"
+)
+
 // ReconnectionState tracks automatic reconnection attempts
 type ReconnectionState struct {
 	Attempt     int           // Current attempt number (1-based)
 	LastAttempt time.Time     // Timestamp of last attempt
 	NextDelay   time.Duration // Delay until next attempt
 	MaxAttempts int           // Maximum attempts before giving up
+
+	// LastErrorCode is the pgconn.PgError.Code behind the most recent
+	// failure, if any. It biases CalculateAdaptiveNextDelay.
+	LastErrorCode string
+	// AcquirePressure is a 0-1 trend (see PoolPressure) captured from the
+	// pool's stats just before the connection was lost.
+	AcquirePressure float64
 }
 
 // NewReconnectionState creates a new reconnection state
@@ -41,16 +60,88 @@ func (r *ReconnectionState) CalculateNextDelay() time.Duration {
 	return delay
 }
 
+// CalculateAdaptiveNextDelay computes the next backoff delay, biased by the
+// class of error behind the last failure and by how much acquire pressure
+// the pool was under just before it went down. Falls back to the plain
+// exponential backoff when neither signal is available.
+func (r *ReconnectionState) CalculateAdaptiveNextDelay() time.Duration {
+	switch r.LastErrorCode {
+	case pgCannotConnectNow:
+		// Transient: the server is coming back up, retry quickly.
+		delay := 500 * time.Millisecond * time.Duration(1<<uint(r.Attempt))
+		if delay > 5*time.Second {
+			delay = 5 * time.Second
+		}
+		return delay
+	case pgInvalidPassword:
+		// Needs operator attention; hammering the server won't help.
+		return 30 * time.Second
+	}
+
+	delay := r.CalculateNextDelay()
+	if r.AcquirePressure > 0.5 {
+		delay += delay / 2
+		if delay > 30*time.Second {
+			delay = 30 * time.Second
+		}
+	}
+	return delay
+}
+
+// RecordFailure records the pgconn.PgError.Code (if any) behind the most
+// recent connection failure, for the next CalculateAdaptiveNextDelay call.
+func (r *ReconnectionState) RecordFailure(err error) {
+	r.LastErrorCode = ""
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		r.LastErrorCode = pgErr.Code
+	}
+}
+
+// RecordPoolPressure captures a pool's acquire-pressure trend (see
+// PoolPressure) ahead of a reconnection attempt.
+func (r *ReconnectionState) RecordPoolPressure(pressure float64) {
+	r.AcquirePressure = pressure
+}
+
+// PoolPressure derives a 0-1 trend from two successive pgxpool.Stat samples:
+// the fraction of acquires made *since prev* that were canceled or had to
+// wait for a new connection rather than acquiring cleanly. AcquireCount and
+// its siblings are lifetime counters, so the trend must come from the delta
+// between samples, not either sample alone - otherwise pressure only ever
+// accumulates and never recovers once enough history has built up. Returns
+// 0 if prev is nil (no baseline yet) or no new acquires happened between
+// samples. Feeds ReconnectionState.RecordPoolPressure.
+func PoolPressure(prev, cur *pgxpool.Stat) float64 {
+	if cur == nil || prev == nil {
+		return 0
+	}
+
+	acquireDelta := cur.AcquireCount() - prev.AcquireCount()
+	if acquireDelta <= 0 {
+		return 0
+	}
+
+	troubledDelta := (cur.CanceledAcquireCount() - prev.CanceledAcquireCount()) +
+		(cur.EmptyAcquireCount() - prev.EmptyAcquireCount())
+	if troubledDelta < 0 {
+		troubledDelta = 0
+	}
+
+	return float64(troubledDelta) / float64(acquireDelta)
+}
+
 // NextAttempt prepares for the next reconnection attempt
 func (r *ReconnectionState) NextAttempt() bool {
 	r.Attempt++
 	r.LastAttempt = time.Now()
-	r.NextDelay = r.CalculateNextDelay()
+	r.NextDelay = r.CalculateAdaptiveNextDelay()
 
 	logger.Debug("Preparing reconnection attempt",
 		"attempt", r.Attempt,
 		"max_attempts", r.MaxAttempts,
 		"next_delay", r.NextDelay,
+		"last_error_code", r.LastErrorCode,
 	)
 
 	return r.Attempt <= r.MaxAttempts
@@ -61,6 +152,8 @@ func (r *ReconnectionState) Reset() {
 	logger.Debug("Resetting reconnection state after successful connection")
 	r.Attempt = 0
 	r.NextDelay = time.Second
+	r.LastErrorCode = ""
+	r.AcquirePressure = 0
 }
 
 // HasAttemptsRemaining returns true if more attempts are available
@@ -93,9 +186,11 @@ func AttemptReconnection(ctx context.Context, cfg *config.Config, state *Reconne
 	// Attempt to create new connection pool
 	pool, err := NewConnectionPool(ctx, cfg)
 	if err != nil {
+		state.RecordFailure(err)
 		logger.Warn("Reconnection attempt failed",
 			"attempt", state.Attempt,
 			"error", err,
+			"error_code", state.LastErrorCode,
 		)
 		return nil, fmt.Errorf("reconnection attempt %d failed: %w", state.Attempt, err)
 	}
@@ -0,0 +1,41 @@
+package jobs
+
+import "context"
+
+// EventKind identifies a job lifecycle transition.
+type EventKind int
+
+const (
+	EventEnqueued EventKind = iota
+	EventStarted
+	EventProgress
+	EventCompleted
+	EventFailed
+)
+
+// Event describes a single job lifecycle transition, emitted from Manager
+// so callers (e.g. the TUI) can react without polling the queue themselves.
+type Event struct {
+	Kind    EventKind
+	Job     Job
+	Percent float64 // set on EventProgress
+	Err     error
+}
+
+// progressCtxKey is the context key a Handler uses to report progress via ReportProgress.
+type progressCtxKey struct{}
+
+// withProgress returns a context that routes ReportProgress calls to report.
+func withProgress(ctx context.Context, report func(percent float64)) context.Context {
+	return context.WithValue(ctx, progressCtxKey{}, report)
+}
+
+// ReportProgress notifies the Manager running the current job of a percent
+// complete (0-100). Handlers that can observe incremental progress (e.g. by
+// polling pg_stat_progress_vacuum) should call this as they go; it is a
+// no-op when ctx carries no progress sink.
+func ReportProgress(ctx context.Context, percent float64) {
+	if report, ok := ctx.Value(progressCtxKey{}).(func(percent float64)); ok {
+		report(percent)
+	}
+}
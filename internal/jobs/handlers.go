@@ -0,0 +1,80 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/willibrandon/steep/internal/db/queries"
+)
+
+// Maintenance job kinds understood by RegisterMaintenanceHandlers.
+const (
+	KindVacuum  = "vacuum"
+	KindReindex = "reindex"
+)
+
+// VacuumArgs is the JSON payload for a KindVacuum job.
+type VacuumArgs struct {
+	Schema  string `json:"schema"`
+	Table   string `json:"table"`
+	Full    bool   `json:"full"`
+	Analyze bool   `json:"analyze"`
+}
+
+// ReindexArgs is the JSON payload for a KindReindex job.
+type ReindexArgs struct {
+	Schema string `json:"schema"`
+	Index  string `json:"index"`
+}
+
+// RegisterMaintenanceHandlers wires the built-in VACUUM/REINDEX job kinds
+// into m, executing them against pool via internal/db/queries.
+func RegisterMaintenanceHandlers(m *Manager, pool *pgxpool.Pool) {
+	m.RegisterHandler(KindVacuum, func(ctx context.Context, j Job) error {
+		var args VacuumArgs
+		if err := json.Unmarshal(j.Args, &args); err != nil {
+			return fmt.Errorf("unmarshal vacuum args: %w", err)
+		}
+
+		progressCtx, stopProgress := context.WithCancel(ctx)
+		defer stopProgress()
+		go pollVacuumProgress(progressCtx, pool, args.Schema, args.Table)
+
+		return queries.ExecuteVacuumWithOptions(ctx, pool, args.Schema, args.Table, queries.VacuumOptions{
+			Full:    args.Full,
+			Analyze: args.Analyze,
+		})
+	})
+
+	m.RegisterHandler(KindReindex, func(ctx context.Context, j Job) error {
+		var args ReindexArgs
+		if err := json.Unmarshal(j.Args, &args); err != nil {
+			return fmt.Errorf("unmarshal reindex args: %w", err)
+		}
+		return queries.ExecuteReindexIndex(ctx, pool, args.Schema, args.Index)
+	})
+}
+
+// pollVacuumProgress reports VACUUM progress via ReportProgress until ctx is
+// cancelled (the caller cancels it as soon as the VACUUM itself returns).
+func pollVacuumProgress(ctx context.Context, pool *pgxpool.Pool, schema, table string) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			progress, err := queries.GetVacuumProgress(ctx, pool, schema, table)
+			if err != nil || progress == nil {
+				continue
+			}
+			ReportProgress(ctx, progress.PercentComplete)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+// Package jobs provides a transactional job queue for scheduled maintenance
+// tasks (REINDEX, VACUUM, statistics refresh, extension installs) that run
+// against the monitored PostgreSQL instance. It is modeled on transaction-
+// level advisory-lock queues (a la gue): jobs live in a steep_jobs table and
+// workers claim a batch with SELECT ... FOR UPDATE SKIP LOCKED, committing
+// the claim transaction before running the handler (VACUUM cannot run
+// inside a transaction block, so the claim lock can't be held across it).
+// A worker that crashes mid-job leaves its row's lock released but its
+// status stuck at 'running'; Manager.pollOnce sweeps those back to
+// 'pending' once they've sat past the manager's stale timeout (see
+// Queue.ReapStuck), so no job is lost to a crash.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Status represents the lifecycle state of a queued job.
+type Status string
+
+const (
+	StatusPending Status = "pending"
+	StatusRunning Status = "running"
+	StatusDone    Status = "done"
+	StatusFailed  Status = "failed"
+)
+
+// Job is a single unit of work in the steep_jobs table.
+type Job struct {
+	ID         int64
+	Queue      string
+	Kind       string
+	Args       json.RawMessage
+	RunAt      time.Time
+	Every      time.Duration // 0 for one-shot jobs; recurring jobs re-enqueue themselves on completion
+	Attempts   int
+	LastError  string
+	LockedBy   string
+	Status     Status
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+// Queue manages the steep_jobs table on the monitored PostgreSQL instance.
+type Queue struct {
+	pool *pgxpool.Pool
+}
+
+// NewQueue creates a Queue backed by pool.
+func NewQueue(pool *pgxpool.Pool) *Queue {
+	return &Queue{pool: pool}
+}
+
+// EnsureSchema creates the steep_jobs table if it doesn't already exist.
+func (q *Queue) EnsureSchema(ctx context.Context) error {
+	const schema = `
+	CREATE TABLE IF NOT EXISTS steep_jobs (
+		id           BIGSERIAL PRIMARY KEY,
+		queue        TEXT NOT NULL DEFAULT 'default',
+		kind         TEXT NOT NULL,
+		args         JSONB NOT NULL DEFAULT '{}',
+		run_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+		every_ms     BIGINT NOT NULL DEFAULT 0,
+		attempts     INT NOT NULL DEFAULT 0,
+		last_error   TEXT,
+		locked_by    TEXT,
+		locked_at    TIMESTAMPTZ,
+		status       TEXT NOT NULL DEFAULT 'pending',
+		created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+		finished_at  TIMESTAMPTZ
+	);
+
+	ALTER TABLE steep_jobs ADD COLUMN IF NOT EXISTS locked_at TIMESTAMPTZ;
+
+	CREATE INDEX IF NOT EXISTS idx_steep_jobs_claim ON steep_jobs (queue, run_at)
+		WHERE status = 'pending';
+	`
+	if _, err := q.pool.Exec(ctx, schema); err != nil {
+		return fmt.Errorf("ensure steep_jobs schema: %w", err)
+	}
+	return nil
+}
+
+// EnqueueOptions configures a newly enqueued job.
+type EnqueueOptions struct {
+	Queue string        // defaults to "default"
+	RunAt time.Time     // defaults to now
+	Every time.Duration // 0 for a one-shot job, >0 to re-enqueue on completion
+}
+
+// Enqueue inserts a new job and returns it with its assigned ID.
+func (q *Queue) Enqueue(ctx context.Context, kind string, args any, opts EnqueueOptions) (Job, error) {
+	queue := opts.Queue
+	if queue == "" {
+		queue = "default"
+	}
+	runAt := opts.RunAt
+	if runAt.IsZero() {
+		runAt = time.Now()
+	}
+
+	argsJSON, err := json.Marshal(args)
+	if err != nil {
+		return Job{}, fmt.Errorf("marshal job args: %w", err)
+	}
+
+	var job Job
+	var everyMs int64
+	err = q.pool.QueryRow(ctx, `
+		INSERT INTO steep_jobs (queue, kind, args, run_at, every_ms)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, queue, kind, args, run_at, every_ms, attempts, status, created_at
+	`, queue, kind, argsJSON, runAt, opts.Every.Milliseconds()).Scan(
+		&job.ID, &job.Queue, &job.Kind, &job.Args, &job.RunAt, &everyMs,
+		&job.Attempts, &job.Status, &job.CreatedAt,
+	)
+	if err != nil {
+		return Job{}, fmt.Errorf("enqueue job %q: %w", kind, err)
+	}
+	job.Every = time.Duration(everyMs) * time.Millisecond
+	return job, nil
+}
+
+// EnsureRecurring seeds a recurring job for kind and args on queue unless
+// one with the same kind and args is already pending or running there, so callers (e.g. a config-driven
+// scheduler run on every connect) can call it idempotently without piling
+// up duplicate rows across reconnects and restarts. Reports whether a new
+// job was actually inserted.
+func (q *Queue) EnsureRecurring(ctx context.Context, kind string, args any, opts EnqueueOptions) (Job, bool, error) {
+	queue := opts.Queue
+	if queue == "" {
+		queue = "default"
+	}
+
+	argsJSON, err := json.Marshal(args)
+	if err != nil {
+		return Job{}, false, fmt.Errorf("marshal job args: %w", err)
+	}
+
+	var exists bool
+	err = q.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM steep_jobs
+			WHERE queue = $1 AND kind = $2 AND args = $3::jsonb
+				AND status IN ('pending', 'running') AND every_ms > 0
+		)
+	`, queue, kind, argsJSON).Scan(&exists)
+	if err != nil {
+		return Job{}, false, fmt.Errorf("check existing recurring job %q: %w", kind, err)
+	}
+	if exists {
+		return Job{}, false, nil
+	}
+
+	job, err := q.Enqueue(ctx, kind, args, opts)
+	if err != nil {
+		return Job{}, false, err
+	}
+	return job, true, nil
+}
+
+// ClaimBatch locks up to limit pending, due jobs on queue for workerID and
+// marks them running, using SELECT ... FOR UPDATE SKIP LOCKED so concurrent
+// workers never claim the same job twice.
+func (q *Queue) ClaimBatch(ctx context.Context, queue string, limit int, workerID string) ([]Job, error) {
+	tx, err := q.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin claim transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, queue, kind, args, run_at, every_ms, attempts, status, created_at
+		FROM steep_jobs
+		WHERE queue = $1 AND status = 'pending' AND run_at <= now()
+		ORDER BY run_at
+		FOR UPDATE SKIP LOCKED
+		LIMIT $2
+	`, queue, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim batch: %w", err)
+	}
+
+	var claimed []Job
+	for rows.Next() {
+		var j Job
+		var everyMs int64
+		if err := rows.Scan(&j.ID, &j.Queue, &j.Kind, &j.Args, &j.RunAt, &everyMs,
+			&j.Attempts, &j.Status, &j.CreatedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan claimed job: %w", err)
+		}
+		j.Every = time.Duration(everyMs) * time.Millisecond
+		claimed = append(claimed, j)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("claim batch: %w", err)
+	}
+
+	for _, j := range claimed {
+		if _, err := tx.Exec(ctx, `
+			UPDATE steep_jobs SET status = 'running', locked_by = $1, locked_at = now() WHERE id = $2
+		`, workerID, j.ID); err != nil {
+			return nil, fmt.Errorf("lock job %d: %w", j.ID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit claim transaction: %w", err)
+	}
+	return claimed, nil
+}
+
+// ReapStuck resets jobs on queue that have been stuck in 'running' since
+// before the given cutoff back to 'pending' so they can be claimed again.
+// A worker crashing mid-job releases its row lock immediately (the claim
+// transaction already committed before the handler ran), but leaves the
+// row's status at 'running' with no lock to show it's actually orphaned;
+// this visibility-timeout sweep is what reclaims it. Returns the number of
+// jobs reset.
+func (q *Queue) ReapStuck(ctx context.Context, queue string, cutoff time.Time) (int64, error) {
+	tag, err := q.pool.Exec(ctx, `
+		UPDATE steep_jobs
+		SET status = 'pending', locked_by = NULL, locked_at = NULL
+		WHERE queue = $1 AND status = 'running' AND locked_at < $2
+	`, queue, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("reap stuck jobs: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// Complete marks a job done and, if it recurs, enqueues its next occurrence.
+func (q *Queue) Complete(ctx context.Context, j Job) error {
+	_, err := q.pool.Exec(ctx, `
+		UPDATE steep_jobs SET status = 'done', finished_at = now() WHERE id = $1
+	`, j.ID)
+	if err != nil {
+		return fmt.Errorf("complete job %d: %w", j.ID, err)
+	}
+	if j.Every > 0 {
+		_, err := q.Enqueue(ctx, j.Kind, j.Args, EnqueueOptions{
+			Queue: j.Queue,
+			RunAt: time.Now().Add(j.Every),
+			Every: j.Every,
+		})
+		if err != nil {
+			return fmt.Errorf("reschedule recurring job %d: %w", j.ID, err)
+		}
+	}
+	return nil
+}
+
+// Fail records a job failure. If attempts remain under maxAttempts, the job
+// is rescheduled at nextRunAt with status reset to pending; otherwise it is
+// marked failed for good.
+func (q *Queue) Fail(ctx context.Context, j Job, cause error, nextRunAt time.Time, maxAttempts int) error {
+	attempts := j.Attempts + 1
+	status := StatusPending
+	if attempts >= maxAttempts {
+		status = StatusFailed
+	}
+
+	_, err := q.pool.Exec(ctx, `
+		UPDATE steep_jobs
+		SET status = $1, attempts = $2, last_error = $3, run_at = $4, locked_by = NULL
+		WHERE id = $5
+	`, status, attempts, cause.Error(), nextRunAt, j.ID)
+	if err != nil {
+		return fmt.Errorf("fail job %d: %w", j.ID, err)
+	}
+	return nil
+}
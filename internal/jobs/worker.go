@@ -0,0 +1,201 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/willibrandon/steep/internal/logger"
+)
+
+// Handler executes a single job and returns an error if it failed.
+type Handler func(ctx context.Context, j Job) error
+
+// Manager polls a Queue for due jobs and runs them against registered
+// handlers, emitting lifecycle events as it goes.
+type Manager struct {
+	queue        *Queue
+	queueName    string
+	pollEvery    time.Duration
+	batchSize    int
+	maxAttempts  int
+	workerID     string
+	staleTimeout time.Duration
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+
+	onEvent func(Event)
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// ManagerOption configures a Manager.
+type ManagerOption func(*Manager)
+
+// WithQueueName selects which steep_jobs queue this manager polls (default "default").
+func WithQueueName(name string) ManagerOption {
+	return func(m *Manager) { m.queueName = name }
+}
+
+// WithPollInterval sets how often the manager polls for due jobs.
+func WithPollInterval(d time.Duration) ManagerOption {
+	return func(m *Manager) { m.pollEvery = d }
+}
+
+// WithBatchSize sets how many jobs are claimed per poll.
+func WithBatchSize(n int) ManagerOption {
+	return func(m *Manager) { m.batchSize = n }
+}
+
+// WithMaxAttempts sets how many times a failing job is retried before being
+// marked permanently failed.
+func WithMaxAttempts(n int) ManagerOption {
+	return func(m *Manager) { m.maxAttempts = n }
+}
+
+// WithStaleTimeout sets how long after being claimed a job may sit in
+// 'running' before ReapStuck resets it to 'pending' for a crashed worker's
+// job to be reclaimed. The timeout counts from the claim; progress reports
+// do not extend it.
+func WithStaleTimeout(d time.Duration) ManagerOption {
+	return func(m *Manager) { m.staleTimeout = d }
+}
+
+// WithEventHandler registers a callback invoked for every job lifecycle event.
+func WithEventHandler(fn func(Event)) ManagerOption {
+	return func(m *Manager) { m.onEvent = fn }
+}
+
+// NewManager creates a Manager that runs jobs from queue.
+func NewManager(queue *Queue, workerID string, opts ...ManagerOption) *Manager {
+	m := &Manager{
+		queue:        queue,
+		queueName:    "default",
+		pollEvery:    5 * time.Second,
+		batchSize:    4,
+		maxAttempts:  5,
+		workerID:     workerID,
+		staleTimeout: 10 * time.Minute,
+		handlers:     make(map[string]Handler),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// RegisterHandler associates kind with the function that executes it.
+// A claimed job whose kind has no registered handler is recorded as a failed
+// attempt (and retried with backoff like any other failure).
+func (m *Manager) RegisterHandler(kind string, fn Handler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[kind] = fn
+}
+
+// Run polls for due jobs on a ticker until ctx is cancelled or Stop is called.
+func (m *Manager) Run(ctx context.Context) {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.pollEvery)
+	defer ticker.Stop()
+
+	for {
+		m.pollOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop signals Run to exit and waits for it to return.
+func (m *Manager) Stop() {
+	close(m.stop)
+	<-m.done
+}
+
+func (m *Manager) pollOnce(ctx context.Context) {
+	if n, err := m.queue.ReapStuck(ctx, m.queueName, time.Now().Add(-m.staleTimeout)); err != nil {
+		m.emit(Event{Kind: EventFailed, Err: err})
+	} else if n > 0 {
+		logger.Warn("reclaimed jobs stuck past their stale timeout", "queue", m.queueName, "count", n)
+	}
+
+	claimed, err := m.queue.ClaimBatch(ctx, m.queueName, m.batchSize, m.workerID)
+	if err != nil {
+		m.emit(Event{Kind: EventFailed, Err: err})
+		return
+	}
+	for _, j := range claimed {
+		m.runJob(ctx, j)
+	}
+}
+
+func (m *Manager) runJob(ctx context.Context, j Job) {
+	m.emit(Event{Kind: EventStarted, Job: j})
+
+	m.mu.RLock()
+	handler, ok := m.handlers[j.Kind]
+	m.mu.RUnlock()
+
+	if !ok {
+		err := fmt.Errorf("no handler registered for job kind %q", j.Kind)
+		m.fail(ctx, j, err)
+		return
+	}
+
+	ctx = withProgress(ctx, func(percent float64) {
+		m.emit(Event{Kind: EventProgress, Job: j, Percent: percent})
+	})
+
+	if err := handler(ctx, j); err != nil {
+		m.fail(ctx, j, err)
+		return
+	}
+
+	if err := m.queue.Complete(ctx, j); err != nil {
+		m.emit(Event{Kind: EventFailed, Job: j, Err: err})
+		return
+	}
+	m.emit(Event{Kind: EventCompleted, Job: j})
+}
+
+func (m *Manager) fail(ctx context.Context, j Job, cause error) {
+	nextRunAt := time.Now().Add(Backoff(j.Attempts))
+	if err := m.queue.Fail(ctx, j, cause, nextRunAt, m.maxAttempts); err != nil {
+		m.emit(Event{Kind: EventFailed, Job: j, Err: err})
+		return
+	}
+	m.emit(Event{Kind: EventFailed, Job: j, Err: cause})
+}
+
+func (m *Manager) emit(ev Event) {
+	if m.onEvent != nil {
+		m.onEvent(ev)
+	}
+}
+
+// Backoff computes an exponential backoff delay (capped at 5 minutes) for
+// a job that has failed attempts times so far.
+func Backoff(attempts int) time.Duration {
+	const (
+		base     = 500 * time.Millisecond
+		maxDelay = 5 * time.Minute
+	)
+	delay := base * time.Duration(math.Pow(2, float64(attempts)))
+	if delay > maxDelay || delay <= 0 {
+		return maxDelay
+	}
+	return delay
+}
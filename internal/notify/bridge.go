@@ -0,0 +1,142 @@
+// Package notify bridges Postgres LISTEN/NOTIFY into the TUI's message
+// loop: a single dedicated connection LISTENs on a configured set of
+// channels for the process lifetime, and each incoming notification is
+// pushed onto a bounded, drop-oldest channel for the app to forward as a
+// Bubble Tea message.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Notification is a single Postgres NOTIFY delivered to a LISTENed channel.
+type Notification struct {
+	Channel   string
+	Payload   string
+	PID       uint32
+	Timestamp time.Time
+}
+
+// Bridge owns the dedicated LISTEN connection and the bounded buffer of
+// notifications received on it. A Bridge is meant to live for the process
+// lifetime: on reconnect, callers should point it at the new pool with
+// SetPool and re-invoke Listen rather than constructing a new Bridge, so
+// the single forwarding goroutine draining Notifications stays attached to
+// a channel that keeps receiving pushes.
+type Bridge struct {
+	mu       sync.Mutex
+	pool     *pgxpool.Pool
+	channels []string
+	out      chan Notification
+	cancel   context.CancelFunc
+}
+
+// NewBridge creates a bridge that will LISTEN on channels once Listen is
+// called. bufferSize bounds how many unread notifications are kept; once
+// full, the oldest is dropped to make room for the newest.
+func NewBridge(pool *pgxpool.Pool, channels []string, bufferSize int) *Bridge {
+	if bufferSize <= 0 {
+		bufferSize = 200
+	}
+	return &Bridge{
+		pool:     pool,
+		channels: channels,
+		out:      make(chan Notification, bufferSize),
+	}
+}
+
+// Notifications returns the channel notifications are delivered on.
+func (b *Bridge) Notifications() <-chan Notification {
+	return b.out
+}
+
+// SetPool points the bridge at a fresh pool, used after a reconnect so the
+// next call to Listen resumes on the new connection without losing buffered
+// notifications or the forwarding goroutine already draining Notifications.
+func (b *Bridge) SetPool(pool *pgxpool.Pool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pool = pool
+}
+
+// Stop cancels any in-progress Listen call, releasing its dedicated
+// connection. Safe to call even if Listen was never started or has already
+// returned. Call it once, on app shutdown.
+func (b *Bridge) Stop() {
+	b.mu.Lock()
+	cancel := b.cancel
+	b.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// Listen acquires a dedicated connection, issues LISTEN for every configured
+// channel, then blocks relaying notifications until ctx is canceled, Stop is
+// called, or the connection is lost. Callers should re-invoke Listen
+// (typically after a reconnect, via SetPool) to resume listening on a fresh
+// connection.
+func (b *Bridge) Listen(ctx context.Context) error {
+	if len(b.channels) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	b.mu.Lock()
+	b.cancel = cancel
+	pool := b.pool
+	b.mu.Unlock()
+	defer cancel()
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire dedicated listen connection: %w", err)
+	}
+	defer conn.Release()
+
+	for _, channel := range b.channels {
+		stmt := "LISTEN " + pgx.Identifier{channel}.Sanitize()
+		if _, err := conn.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("listen on %q: %w", channel, err)
+		}
+	}
+
+	for {
+		pgNotification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+		b.push(Notification{
+			Channel:   pgNotification.Channel,
+			Payload:   pgNotification.Payload,
+			PID:       pgNotification.PID,
+			Timestamp: time.Now(),
+		})
+	}
+}
+
+// push delivers n, dropping the oldest buffered notification if out is full
+// so a slow or absent reader never blocks the listening goroutine.
+func (b *Bridge) push(n Notification) {
+	select {
+	case b.out <- n:
+		return
+	default:
+	}
+
+	select {
+	case <-b.out:
+	default:
+	}
+
+	select {
+	case b.out <- n:
+	default:
+	}
+}
@@ -25,23 +25,42 @@ type ParallelTableCopier struct {
 	bytesComplete  int64
 	logger         *Logger
 	progressFn     func(completed, total int, currentTable string, percent float64)
+	copyFn         func(ctx context.Context, task TableCopyTask, workerID int) ParallelCopyResult
+}
+
+// ParallelCopierOption configures a ParallelTableCopier.
+type ParallelCopierOption func(*ParallelTableCopier)
+
+// WithCopyFunc overrides how each table is copied. It is primarily used by
+// tests to substitute the real COPY simulation with a fake, e.g. replinittest.
+func WithCopyFunc(fn func(ctx context.Context, task TableCopyTask, workerID int) ParallelCopyResult) ParallelCopierOption {
+	return func(p *ParallelTableCopier) {
+		p.copyFn = fn
+	}
 }
 
 // NewParallelTableCopier creates a new parallel table copier with the specified number of workers.
-func NewParallelTableCopier(pool *pgxpool.Pool, workers int, logger *Logger) *ParallelTableCopier {
+func NewParallelTableCopier(pool *pgxpool.Pool, workers int, logger *Logger, opts ...ParallelCopierOption) *ParallelTableCopier {
 	if workers < 1 {
 		workers = 1
 	}
 	if workers > 16 {
 		workers = 16
 	}
-	return &ParallelTableCopier{
+	p := &ParallelTableCopier{
 		pool:    pool,
 		workers: workers,
 		tasks:   make(chan TableCopyTask, workers*2),
 		results: make(chan ParallelCopyResult, workers*2),
 		logger:  logger,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.copyFn == nil {
+		p.copyFn = p.copyTable
+	}
+	return p
 }
 
 // SetProgressCallback sets a callback function for progress updates.
@@ -119,7 +138,7 @@ func (p *ParallelTableCopier) worker(ctx context.Context, workerID int) {
 		default:
 		}
 
-		result := p.copyTable(ctx, task, workerID)
+		result := p.copyFn(ctx, task, workerID)
 		p.results <- result
 
 		if result.Error == nil {
@@ -0,0 +1,153 @@
+package tracing
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ExportFormat is the file format written by Export.
+type ExportFormat int
+
+const (
+	ExportFormatCSV ExportFormat = iota
+	ExportFormatJSON
+)
+
+// ExportResult describes the outcome of exporting recorded traces.
+type ExportResult struct {
+	FilePath string
+	RowCount int
+	Format   ExportFormat
+}
+
+// ExportCSV writes traces to filename as CSV (one row per trace).
+func ExportCSV(traces []Trace, filename string) (*ExportResult, error) {
+	absPath, err := expandPath(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(strings.ToLower(absPath), ".csv") {
+		absPath += ".csv"
+	}
+	if err := os.MkdirAll(filepath.Dir(absPath), 0755); err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+
+	file, err := os.Create(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("create file: %w", err)
+	}
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"started_at", "conn", "op", "sql", "duration_ms", "rows_affected", "error"}); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+	for _, t := range traces {
+		errText := ""
+		if t.Err != nil {
+			errText = t.Err.Error()
+		}
+		record := []string{
+			t.StartedAt.Format("2006-01-02T15:04:05.000Z07:00"),
+			t.Conn,
+			t.Op,
+			t.SQL,
+			strconv.FormatInt(t.Duration.Milliseconds(), 10),
+			strconv.FormatInt(t.RowsAffected, 10),
+			errText,
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("write row: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("csv write error: %w", err)
+	}
+
+	return &ExportResult{FilePath: absPath, RowCount: len(traces), Format: ExportFormatCSV}, nil
+}
+
+// traceJSON is the JSON representation of a Trace (error flattened to a string).
+type traceJSON struct {
+	StartedAt    string `json:"started_at"`
+	Conn         string `json:"conn"`
+	Op           string `json:"op"`
+	SQL          string `json:"sql"`
+	DurationMs   int64  `json:"duration_ms"`
+	RowsAffected int64  `json:"rows_affected"`
+	Error        string `json:"error,omitempty"`
+}
+
+// ExportJSON writes traces to filename as a JSON array.
+func ExportJSON(traces []Trace, filename string) (*ExportResult, error) {
+	absPath, err := expandPath(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(strings.ToLower(absPath), ".json") {
+		absPath += ".json"
+	}
+	if err := os.MkdirAll(filepath.Dir(absPath), 0755); err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+
+	records := make([]traceJSON, len(traces))
+	for i, t := range traces {
+		errText := ""
+		if t.Err != nil {
+			errText = t.Err.Error()
+		}
+		records[i] = traceJSON{
+			StartedAt:    t.StartedAt.Format("2006-01-02T15:04:05.000Z07:00"),
+			Conn:         t.Conn,
+			Op:           t.Op,
+			SQL:          t.SQL,
+			DurationMs:   t.Duration.Milliseconds(),
+			RowsAffected: t.RowsAffected,
+			Error:        errText,
+		}
+	}
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON: %w", err)
+	}
+	if err := os.WriteFile(absPath, data, 0644); err != nil {
+		return nil, fmt.Errorf("write file: %w", err)
+	}
+
+	return &ExportResult{FilePath: absPath, RowCount: len(traces), Format: ExportFormatJSON}, nil
+}
+
+func expandPath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("empty path")
+	}
+	if strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("get home directory: %w", err)
+		}
+		path = filepath.Join(home, path[2:])
+	} else if path == "~" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("get home directory: %w", err)
+		}
+		path = home
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("resolve path: %w", err)
+	}
+	return absPath, nil
+}
@@ -0,0 +1,63 @@
+package tracing
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testTraces() []Trace {
+	return []Trace{
+		{Conn: "localhost:5432", Op: "query", SQL: "SELECT 1", StartedAt: time.Now(), Duration: 2 * time.Millisecond, RowsAffected: 1},
+		{Conn: "localhost:5432", Op: "query", SQL: "SELECT * FROM missing", StartedAt: time.Now(), Duration: time.Millisecond, Err: errors.New("relation \"missing\" does not exist")},
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	tmpDir := t.TempDir()
+	filename := filepath.Join(tmpDir, "traces.csv")
+
+	result, err := ExportCSV(testTraces(), filename)
+	if err != nil {
+		t.Fatalf("ExportCSV failed: %v", err)
+	}
+	if result.RowCount != 2 {
+		t.Errorf("expected 2 rows, got %d", result.RowCount)
+	}
+	if _, err := os.Stat(result.FilePath); err != nil {
+		t.Errorf("expected file to exist: %v", err)
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	tmpDir := t.TempDir()
+	filename := filepath.Join(tmpDir, "traces.json")
+
+	result, err := ExportJSON(testTraces(), filename)
+	if err != nil {
+		t.Fatalf("ExportJSON failed: %v", err)
+	}
+	if result.RowCount != 2 {
+		t.Errorf("expected 2 rows, got %d", result.RowCount)
+	}
+	if _, err := os.Stat(result.FilePath); err != nil {
+		t.Errorf("expected file to exist: %v", err)
+	}
+}
+
+func TestRingSnapshotOrderAndOverwrite(t *testing.T) {
+	r := NewRing(2)
+	r.Add(Trace{SQL: "one"})
+	r.Add(Trace{SQL: "two"})
+	r.Add(Trace{SQL: "three"})
+
+	got := r.Snapshot()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(got))
+	}
+	if got[0].SQL != "two" || got[1].SQL != "three" {
+		t.Errorf("expected [two three], got [%s %s]", got[0].SQL, got[1].SQL)
+	}
+}
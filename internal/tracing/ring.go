@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"sync"
+	"time"
+)
+
+// Trace is a single recorded pgx operation: a query, batch, prepare,
+// copy-from, or connection attempt.
+type Trace struct {
+	Conn         string
+	Op           string // "query", "batch", "batch_query", "connect", "prepare", "copy_from"
+	SQL          string
+	Args         []any
+	StartedAt    time.Time
+	Duration     time.Duration
+	RowsAffected int64
+	Err          error
+}
+
+// Ring is a fixed-size, overwrite-oldest buffer of recent traces, safe for
+// concurrent use by every pool connection's tracer callbacks.
+type Ring struct {
+	mu      sync.Mutex
+	entries []Trace
+	next    int
+	full    bool
+}
+
+// NewRing creates a Ring holding up to size traces. size <= 0 defaults to 500.
+func NewRing(size int) *Ring {
+	if size <= 0 {
+		size = 500
+	}
+	return &Ring{entries: make([]Trace, size)}
+}
+
+// Add records t, overwriting the oldest entry once the ring is full.
+func (r *Ring) Add(t Trace) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[r.next] = t
+	r.next = (r.next + 1) % len(r.entries)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Snapshot returns the ring's contents, oldest first.
+func (r *Ring) Snapshot() []Trace {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		out := make([]Trace, r.next)
+		copy(out, r.entries[:r.next])
+		return out
+	}
+
+	out := make([]Trace, len(r.entries))
+	n := copy(out, r.entries[r.next:])
+	copy(out[n:], r.entries[:r.next])
+	return out
+}
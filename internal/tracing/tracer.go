@@ -0,0 +1,172 @@
+// Package tracing implements a pluggable pgx tracer that records
+// per-query telemetry (SQL text, args, duration, rows affected, errors)
+// into an in-memory ring buffer so the TUI can surface a "Live Queries"
+// panel without depending on pg_stat_statements or log parsing.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type startKey struct{}
+
+type start struct {
+	op   string
+	sql  string
+	args []any
+	at   time.Time
+}
+
+// Tracer implements pgx.QueryTracer, pgx.BatchTracer, pgx.ConnectTracer,
+// pgx.PrepareTracer, and pgx.CopyFromTracer. Install it on
+// pgxpool.Config.ConnConfig.Tracer before pgxpool.NewWithConfig.
+type Tracer struct {
+	ring       *Ring
+	sampleRate float64
+	onTrace    func(Trace)
+}
+
+// New creates a Tracer backed by a ring buffer of bufferSize entries,
+// sampling a fraction (0..1) of operations. onTrace, if non-nil, is called
+// for every sampled trace as it completes (used to push QueryTraceMsg into
+// the Bubble Tea update loop); it may be called from any connection's
+// goroutine.
+func New(bufferSize int, sampleRate float64, onTrace func(Trace)) *Tracer {
+	return &Tracer{
+		ring:       NewRing(bufferSize),
+		sampleRate: sampleRate,
+		onTrace:    onTrace,
+	}
+}
+
+// Recent returns the most recently recorded traces, oldest first.
+func (t *Tracer) Recent() []Trace {
+	return t.ring.Snapshot()
+}
+
+func (t *Tracer) sampled() bool {
+	switch {
+	case t.sampleRate >= 1:
+		return true
+	case t.sampleRate <= 0:
+		return false
+	default:
+		return rand.Float64() < t.sampleRate
+	}
+}
+
+func (t *Tracer) withStart(ctx context.Context, op, sql string, args []any) context.Context {
+	if !t.sampled() {
+		return ctx
+	}
+	return context.WithValue(ctx, startKey{}, start{op: op, sql: sql, args: args, at: time.Now()})
+}
+
+func connAddr(conn *pgx.Conn) string {
+	if conn == nil {
+		return ""
+	}
+	cfg := conn.Config()
+	return fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+}
+
+func (t *Tracer) finish(ctx context.Context, addr string, rows int64, err error) {
+	s, ok := ctx.Value(startKey{}).(start)
+	if !ok {
+		return
+	}
+	tr := Trace{
+		Conn:         addr,
+		Op:           s.op,
+		SQL:          s.sql,
+		Args:         s.args,
+		StartedAt:    s.at,
+		Duration:     time.Since(s.at),
+		RowsAffected: rows,
+		Err:          err,
+	}
+	t.ring.Add(tr)
+	if t.onTrace != nil {
+		t.onTrace(tr)
+	}
+}
+
+// TraceQueryStart implements pgx.QueryTracer.
+func (t *Tracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return t.withStart(ctx, "query", data.SQL, data.Args)
+}
+
+// TraceQueryEnd implements pgx.QueryTracer.
+func (t *Tracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.finish(ctx, connAddr(conn), data.CommandTag.RowsAffected(), data.Err)
+}
+
+// TraceBatchStart implements pgx.BatchTracer.
+func (t *Tracer) TraceBatchStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	return t.withStart(ctx, "batch", "", nil)
+}
+
+// TraceBatchQuery implements pgx.BatchTracer, recording each statement
+// within a batch as its own trace entry.
+func (t *Tracer) TraceBatchQuery(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchQueryData) {
+	if !t.sampled() {
+		return
+	}
+	tr := Trace{
+		Conn:         connAddr(conn),
+		Op:           "batch_query",
+		SQL:          data.SQL,
+		Args:         data.Args,
+		StartedAt:    time.Now(),
+		RowsAffected: data.CommandTag.RowsAffected(),
+		Err:          data.Err,
+	}
+	t.ring.Add(tr)
+	if t.onTrace != nil {
+		t.onTrace(tr)
+	}
+}
+
+// TraceBatchEnd implements pgx.BatchTracer.
+func (t *Tracer) TraceBatchEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchEndData) {
+	t.finish(ctx, connAddr(conn), 0, data.Err)
+}
+
+// TraceConnectStart implements pgx.ConnectTracer.
+func (t *Tracer) TraceConnectStart(ctx context.Context, data pgx.TraceConnectStartData) context.Context {
+	addr := ""
+	if data.ConnConfig != nil {
+		addr = fmt.Sprintf("%s:%d", data.ConnConfig.Host, data.ConnConfig.Port)
+	}
+	return t.withStart(ctx, "connect", addr, nil)
+}
+
+// TraceConnectEnd implements pgx.ConnectTracer.
+func (t *Tracer) TraceConnectEnd(ctx context.Context, data pgx.TraceConnectEndData) {
+	t.finish(ctx, connAddr(data.Conn), 0, data.Err)
+}
+
+// TracePrepareStart implements pgx.PrepareTracer.
+func (t *Tracer) TracePrepareStart(ctx context.Context, conn *pgx.Conn, data pgx.TracePrepareStartData) context.Context {
+	return t.withStart(ctx, "prepare", data.SQL, nil)
+}
+
+// TracePrepareEnd implements pgx.PrepareTracer.
+func (t *Tracer) TracePrepareEnd(ctx context.Context, conn *pgx.Conn, data pgx.TracePrepareEndData) {
+	t.finish(ctx, connAddr(conn), 0, data.Err)
+}
+
+// TraceCopyFromStart implements pgx.CopyFromTracer.
+func (t *Tracer) TraceCopyFromStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceCopyFromStartData) context.Context {
+	return t.withStart(ctx, "copy_from", data.TableName.Sanitize(), nil)
+}
+
+// TraceCopyFromEnd implements pgx.CopyFromTracer.
+func (t *Tracer) TraceCopyFromEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceCopyFromEndData) {
+	t.finish(ctx, connAddr(conn), data.CommandTag.RowsAffected(), data.Err)
+}
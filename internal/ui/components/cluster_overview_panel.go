@@ -0,0 +1,119 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/willibrandon/steep/internal/cluster"
+	"github.com/willibrandon/steep/internal/ui/styles"
+)
+
+// ClusterOverviewPanel shows per-database stats fanned out across every
+// registered cluster instance (see internal/cluster), one column per
+// instance.
+type ClusterOverviewPanel struct {
+	width int
+
+	results map[string]cluster.RowsOrErr
+}
+
+// NewClusterOverviewPanel creates a new cluster overview panel component.
+func NewClusterOverviewPanel() *ClusterOverviewPanel {
+	return &ClusterOverviewPanel{}
+}
+
+// SetWidth sets the width of the panel.
+func (p *ClusterOverviewPanel) SetWidth(width int) {
+	p.width = width
+}
+
+// Record updates the panel with the latest fan-out query results. A nil or
+// single-instance result set clears the panel, since a lone primary is
+// already covered by the rest of the dashboard.
+func (p *ClusterOverviewPanel) Record(results map[string]cluster.RowsOrErr) {
+	if len(results) < 2 {
+		p.results = nil
+		return
+	}
+	p.results = results
+}
+
+// HasResults returns true if the panel has more than one instance to show.
+func (p *ClusterOverviewPanel) HasResults() bool {
+	return len(p.results) > 1
+}
+
+// Height returns the height needed for the panel.
+func (p *ClusterOverviewPanel) Height() int {
+	if !p.HasResults() {
+		return 0
+	}
+	// Title + instance name + body (3 stat lines, or 1 error/no-data line)
+	// of the tallest column + border (2)
+	bodyLines := 1
+	for _, result := range p.results {
+		if result.Err == nil && len(result.Rows) > 0 {
+			bodyLines = 3
+			break
+		}
+	}
+	return bodyLines + 4
+}
+
+// View renders the cluster overview panel as one column per instance.
+func (p *ClusterOverviewPanel) View() string {
+	if !p.HasResults() {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(styles.ColorAccent).Bold(true)
+	title := titleStyle.Render("Cluster Overview")
+
+	names := make([]string, 0, len(p.results))
+	for name := range p.results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	colWidth := (p.width - 2*len(names)) / max(1, len(names))
+	if colWidth < 18 {
+		colWidth = 18
+	}
+
+	columns := make([]string, len(names))
+	for i, name := range names {
+		columns[i] = p.renderColumn(name, p.results[name], colWidth)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, title, lipgloss.JoinHorizontal(lipgloss.Top, columns...))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorMuted).
+		Padding(0, 1).
+		Width(p.width - 2).
+		Render(content)
+}
+
+func (p *ClusterOverviewPanel) renderColumn(name string, result cluster.RowsOrErr, width int) string {
+	nameStyle := lipgloss.NewStyle().Foreground(styles.ColorSuccess).Bold(true)
+	header := nameStyle.Render(name)
+
+	var body string
+	switch {
+	case result.Err != nil:
+		errStyle := lipgloss.NewStyle().Foreground(styles.ColorAlertCritical)
+		body = errStyle.Render(truncate(strings.Join(strings.Fields(result.Err.Error()), " "), width))
+	case len(result.Rows) == 0:
+		body = "no data"
+	default:
+		row := result.Rows[0]
+		body = fmt.Sprintf("backends: %v\ncommits:  %v\nhit/read: %v/%v",
+			row["numbackends"], row["xact_commit"], row["blks_hit"], row["blks_read"])
+	}
+
+	return lipgloss.NewStyle().Width(width).Render(lipgloss.JoinVertical(lipgloss.Left, header, body))
+}
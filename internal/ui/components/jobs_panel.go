@@ -0,0 +1,144 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/willibrandon/steep/internal/jobs"
+	"github.com/willibrandon/steep/internal/ui/styles"
+)
+
+// JobEntry is the panel's view of a single job, refreshed from the jobs
+// package's lifecycle events.
+type JobEntry struct {
+	ID        int64
+	Kind      string
+	Status    jobs.Status
+	Percent   float64
+	LastError string
+}
+
+// maxJobEntries bounds how many recent jobs the panel keeps in memory.
+const maxJobEntries = 10
+
+// JobsPanel displays recently started/completed/failed maintenance jobs.
+type JobsPanel struct {
+	width int
+	jobs  []JobEntry // most recent first
+}
+
+// NewJobsPanel creates a new jobs panel component.
+func NewJobsPanel() *JobsPanel {
+	return &JobsPanel{}
+}
+
+// SetWidth sets the width of the panel.
+func (p *JobsPanel) SetWidth(width int) {
+	p.width = width
+}
+
+// Record applies a jobs.Event to the panel's recent-job list, inserting a
+// new entry on first sight of a job and updating it in place afterward.
+func (p *JobsPanel) Record(ev jobs.Event) {
+	for i := range p.jobs {
+		if p.jobs[i].ID == ev.Job.ID {
+			p.apply(&p.jobs[i], ev)
+			return
+		}
+	}
+
+	entry := JobEntry{ID: ev.Job.ID, Kind: ev.Job.Kind}
+	p.apply(&entry, ev)
+	p.jobs = append([]JobEntry{entry}, p.jobs...)
+	if len(p.jobs) > maxJobEntries {
+		p.jobs = p.jobs[:maxJobEntries]
+	}
+}
+
+func (p *JobsPanel) apply(entry *JobEntry, ev jobs.Event) {
+	switch ev.Kind {
+	case jobs.EventEnqueued:
+		entry.Status = jobs.StatusPending
+	case jobs.EventStarted:
+		entry.Status = jobs.StatusRunning
+	case jobs.EventProgress:
+		entry.Status = jobs.StatusRunning
+		entry.Percent = ev.Percent
+	case jobs.EventCompleted:
+		entry.Status = jobs.StatusDone
+		entry.Percent = 100
+	case jobs.EventFailed:
+		entry.Status = ev.Job.Status
+		if entry.Status == "" {
+			entry.Status = jobs.StatusFailed
+		}
+		if ev.Err != nil {
+			entry.LastError = ev.Err.Error()
+		}
+	}
+}
+
+// HasJobs returns true if the panel has any jobs to display.
+func (p *JobsPanel) HasJobs() bool {
+	return len(p.jobs) > 0
+}
+
+// Height returns the height needed for the panel.
+func (p *JobsPanel) Height() int {
+	if !p.HasJobs() {
+		return 0
+	}
+	return len(p.jobs) + 3
+}
+
+// View renders the jobs panel.
+func (p *JobsPanel) View() string {
+	if !p.HasJobs() {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(styles.ColorAccent).Bold(true)
+	title := titleStyle.Render("Jobs")
+
+	lines := make([]string, len(p.jobs))
+	for i, j := range p.jobs {
+		lines[i] = p.renderJob(j)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, title, strings.Join(lines, "\n"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorMuted).
+		Padding(0, 1).
+		Width(p.width - 2).
+		Render(content)
+}
+
+func (p *JobsPanel) renderJob(j JobEntry) string {
+	var icon string
+	var iconStyle lipgloss.Style
+	switch j.Status {
+	case jobs.StatusDone:
+		icon = "✓"
+		iconStyle = lipgloss.NewStyle().Foreground(styles.ColorSuccess)
+	case jobs.StatusFailed:
+		icon = "✗"
+		iconStyle = lipgloss.NewStyle().Foreground(styles.ColorAlertCritical)
+	default:
+		icon = "●"
+		iconStyle = lipgloss.NewStyle().Foreground(styles.ColorAccent)
+	}
+
+	status := fmt.Sprintf("%s #%d", j.Kind, j.ID)
+	if j.Status == jobs.StatusRunning && j.Percent > 0 {
+		status = fmt.Sprintf("%s (%.0f%%)", status, j.Percent)
+	}
+	if j.Status == jobs.StatusFailed && j.LastError != "" {
+		status = fmt.Sprintf("%s: %s", status, j.LastError)
+	}
+
+	return fmt.Sprintf("%s %s", iconStyle.Render(icon), status)
+}
@@ -0,0 +1,174 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/willibrandon/steep/internal/notify"
+	"github.com/willibrandon/steep/internal/ui/styles"
+)
+
+// maxNotificationsPerChannel bounds how many recent notifications the panel
+// keeps in memory for any one channel.
+const maxNotificationsPerChannel = 10
+
+// NotificationPanel shows the most recently received LISTEN/NOTIFY
+// notifications (see internal/notify), with an optional single-channel
+// filter.
+type NotificationPanel struct {
+	width int
+
+	byChannel map[string][]notify.Notification // most recent first, per channel
+	order     []string                         // channels in first-seen order, for cycling the filter
+
+	filter string // "" shows all channels
+}
+
+// NewNotificationPanel creates a new notification panel component.
+func NewNotificationPanel() *NotificationPanel {
+	return &NotificationPanel{
+		byChannel: make(map[string][]notify.Notification),
+	}
+}
+
+// SetWidth sets the width of the panel.
+func (p *NotificationPanel) SetWidth(width int) {
+	p.width = width
+}
+
+// Record adds a newly received notification to the panel.
+func (p *NotificationPanel) Record(n notify.Notification) {
+	if _, ok := p.byChannel[n.Channel]; !ok {
+		p.order = append(p.order, n.Channel)
+	}
+
+	entries := append([]notify.Notification{n}, p.byChannel[n.Channel]...)
+	if len(entries) > maxNotificationsPerChannel {
+		entries = entries[:maxNotificationsPerChannel]
+	}
+	p.byChannel[n.Channel] = entries
+}
+
+// HasNotifications returns true if the panel has any notifications to
+// display for the current filter.
+func (p *NotificationPanel) HasNotifications() bool {
+	return len(p.Recent()) > 0
+}
+
+// CycleFilter advances the channel filter through "all channels" followed by
+// each channel seen so far, in first-seen order.
+func (p *NotificationPanel) CycleFilter() {
+	if len(p.order) == 0 {
+		p.filter = ""
+		return
+	}
+
+	if p.filter == "" {
+		p.filter = p.order[0]
+		return
+	}
+
+	for i, ch := range p.order {
+		if ch == p.filter {
+			if i+1 < len(p.order) {
+				p.filter = p.order[i+1]
+			} else {
+				p.filter = ""
+			}
+			return
+		}
+	}
+
+	p.filter = ""
+}
+
+// Filter returns the currently selected channel filter, or "" for all
+// channels.
+func (p *NotificationPanel) Filter() string {
+	return p.filter
+}
+
+// Recent returns the notifications currently held by the panel for the
+// active filter, most recent first. With no filter it returns only the
+// newest maxNotificationsPerChannel notifications across all channels.
+func (p *NotificationPanel) Recent() []notify.Notification {
+	if p.filter != "" {
+		return p.byChannel[p.filter]
+	}
+
+	var all []notify.Notification
+	for _, ch := range p.order {
+		all = append(all, p.byChannel[ch]...)
+	}
+	sortNotificationsDesc(all)
+	if len(all) > maxNotificationsPerChannel {
+		all = all[:maxNotificationsPerChannel]
+	}
+	return all
+}
+
+// sortNotificationsDesc sorts notifications newest first, in place.
+func sortNotificationsDesc(ns []notify.Notification) {
+	for i := 1; i < len(ns); i++ {
+		for j := i; j > 0 && ns[j].Timestamp.After(ns[j-1].Timestamp); j-- {
+			ns[j], ns[j-1] = ns[j-1], ns[j]
+		}
+	}
+}
+
+// Height returns the height needed for the panel.
+func (p *NotificationPanel) Height() int {
+	entries := p.Recent()
+	if len(entries) == 0 {
+		return 0
+	}
+	return len(entries) + 3
+}
+
+// View renders the notification panel.
+func (p *NotificationPanel) View() string {
+	entries := p.Recent()
+	if len(entries) == 0 {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(styles.ColorAccent).Bold(true)
+	title := titleStyle.Render("Notifications")
+	if p.filter != "" {
+		filterStyle := lipgloss.NewStyle().Foreground(styles.ColorMuted)
+		title += filterStyle.Render(fmt.Sprintf(" (channel: %s)", p.filter))
+	}
+
+	lines := make([]string, len(entries))
+	for i, n := range entries {
+		lines[i] = p.renderNotification(n)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, title, strings.Join(lines, "\n"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorMuted).
+		Padding(0, 1).
+		Width(p.width - 2).
+		Render(content)
+}
+
+func (p *NotificationPanel) renderNotification(n notify.Notification) string {
+	channelStyle := lipgloss.NewStyle().Foreground(styles.ColorSuccess)
+
+	payload := strings.TrimSpace(n.Payload)
+	payload = strings.Join(strings.Fields(payload), " ")
+	maxPayloadLen := p.width - 40
+	if maxPayloadLen < 20 {
+		maxPayloadLen = 20
+	}
+	if len(payload) > maxPayloadLen {
+		payload = payload[:maxPayloadLen-1] + "…"
+	}
+
+	return fmt.Sprintf("%s %-20s pid=%-8d %s",
+		n.Timestamp.Format("15:04:05"), channelStyle.Render(n.Channel), n.PID, payload)
+}
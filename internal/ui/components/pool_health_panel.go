@@ -0,0 +1,106 @@
+package components
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/willibrandon/steep/internal/ui/styles"
+)
+
+// maxPoolStatsHistory bounds how many polled samples the sparklines retain.
+const maxPoolStatsHistory = 60
+
+// PoolHealthPanel shows a live summary of the connection pool's health using
+// the stats pgxpool.Pool.Stat() exposes.
+type PoolHealthPanel struct {
+	width int
+
+	stat *pgxpool.Stat
+
+	idleHistory    []float64 // IdleConns per sample
+	acquireHistory []float64 // AcquireDuration per sample, in milliseconds
+}
+
+// NewPoolHealthPanel creates a new pool health panel component.
+func NewPoolHealthPanel() *PoolHealthPanel {
+	return &PoolHealthPanel{}
+}
+
+// SetWidth sets the width of the panel.
+func (p *PoolHealthPanel) SetWidth(width int) {
+	p.width = width
+}
+
+// Record updates the panel with a newly polled pgxpool.Stat.
+func (p *PoolHealthPanel) Record(stat *pgxpool.Stat) {
+	p.stat = stat
+	if stat == nil {
+		return
+	}
+
+	p.idleHistory = append(p.idleHistory, float64(stat.IdleConns()))
+	if len(p.idleHistory) > maxPoolStatsHistory {
+		p.idleHistory = p.idleHistory[len(p.idleHistory)-maxPoolStatsHistory:]
+	}
+
+	p.acquireHistory = append(p.acquireHistory, float64(stat.AcquireDuration().Milliseconds()))
+	if len(p.acquireHistory) > maxPoolStatsHistory {
+		p.acquireHistory = p.acquireHistory[len(p.acquireHistory)-maxPoolStatsHistory:]
+	}
+}
+
+// HasStats returns true if the panel has received at least one sample.
+func (p *PoolHealthPanel) HasStats() bool {
+	return p.stat != nil
+}
+
+// Height returns the height needed for the panel.
+func (p *PoolHealthPanel) Height() int {
+	if !p.HasStats() {
+		return 0
+	}
+	// Title + idle, acquire and counts lines + border (2)
+	return 6
+}
+
+// View renders the pool health panel.
+func (p *PoolHealthPanel) View() string {
+	if !p.HasStats() {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(styles.ColorAccent).Bold(true)
+	title := titleStyle.Render("Pool Health")
+
+	sparklineWidth := p.width - 24
+	if sparklineWidth < 10 {
+		sparklineWidth = 10
+	}
+
+	idleLine := fmt.Sprintf("Idle    %s %d/%d conns",
+		RenderSparkline(p.idleHistory, SparklineConfig{Width: sparklineWidth, Height: 1, Color: lipgloss.Color("42")}),
+		p.stat.IdleConns(), p.stat.TotalConns())
+
+	acquireLine := fmt.Sprintf("Acquire %s %s",
+		RenderSparkline(p.acquireHistory, SparklineConfig{Width: sparklineWidth, Height: 1, Color: lipgloss.Color("117")}),
+		p.stat.AcquireDuration().Round(time.Millisecond))
+
+	countsStyle := lipgloss.NewStyle().Foreground(styles.ColorMuted)
+	countsLine := countsStyle.Render(fmt.Sprintf(
+		"new=%d canceled=%d empty=%d max_lifetime=%d max_idle=%d constructing=%d",
+		p.stat.NewConnsCount(), p.stat.CanceledAcquireCount(), p.stat.EmptyAcquireCount(),
+		p.stat.MaxLifetimeDestroyCount(), p.stat.MaxIdleDestroyCount(), p.stat.ConstructingConns(),
+	))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, title, idleLine, acquireLine, countsLine)
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorMuted).
+		Padding(0, 1).
+		Width(p.width - 2).
+		Render(content)
+}
@@ -0,0 +1,107 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/willibrandon/steep/internal/tracing"
+	"github.com/willibrandon/steep/internal/ui/styles"
+)
+
+// maxTraceEntries bounds how many recent traces the panel keeps in memory.
+const maxTraceEntries = 10
+
+// QueryTracePanel shows the most recently traced pgx operations (see
+// internal/tracing), for a live view of what's hitting the connection.
+type QueryTracePanel struct {
+	width  int
+	traces []tracing.Trace // most recent first
+}
+
+// NewQueryTracePanel creates a new query trace panel component.
+func NewQueryTracePanel() *QueryTracePanel {
+	return &QueryTracePanel{}
+}
+
+// SetWidth sets the width of the panel.
+func (p *QueryTracePanel) SetWidth(width int) {
+	p.width = width
+}
+
+// Record adds a newly completed trace to the panel's recent list.
+func (p *QueryTracePanel) Record(t tracing.Trace) {
+	p.traces = append([]tracing.Trace{t}, p.traces...)
+	if len(p.traces) > maxTraceEntries {
+		p.traces = p.traces[:maxTraceEntries]
+	}
+}
+
+// HasTraces returns true if the panel has any traces to display.
+func (p *QueryTracePanel) HasTraces() bool {
+	return len(p.traces) > 0
+}
+
+// Recent returns the traces currently held by the panel, most recent first.
+func (p *QueryTracePanel) Recent() []tracing.Trace {
+	return p.traces
+}
+
+// Height returns the height needed for the panel.
+func (p *QueryTracePanel) Height() int {
+	if !p.HasTraces() {
+		return 0
+	}
+	return len(p.traces) + 3
+}
+
+// View renders the query trace panel.
+func (p *QueryTracePanel) View() string {
+	if !p.HasTraces() {
+		return ""
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(styles.ColorAccent).Bold(true)
+	title := titleStyle.Render("Live Queries")
+
+	lines := make([]string, len(p.traces))
+	for i, t := range p.traces {
+		lines[i] = p.renderTrace(t)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, title, strings.Join(lines, "\n"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.ColorMuted).
+		Padding(0, 1).
+		Width(p.width - 2).
+		Render(content)
+}
+
+func (p *QueryTracePanel) renderTrace(t tracing.Trace) string {
+	icon := "●"
+	iconStyle := lipgloss.NewStyle().Foreground(styles.ColorSuccess)
+	if t.Err != nil {
+		icon = "✗"
+		iconStyle = lipgloss.NewStyle().Foreground(styles.ColorAlertCritical)
+	}
+
+	sql := strings.TrimSpace(t.SQL)
+	sql = strings.Join(strings.Fields(sql), " ")
+	maxSQLLen := p.width - 40
+	if maxSQLLen < 20 {
+		maxSQLLen = 20
+	}
+	if len(sql) > maxSQLLen {
+		sql = sql[:maxSQLLen-1] + "…"
+	}
+
+	line := fmt.Sprintf("%s %s %6s  %s", iconStyle.Render(icon), t.Op, t.Duration.Round(time.Millisecond), sql)
+	if t.Err != nil {
+		line = fmt.Sprintf("%s: %s", line, t.Err.Error())
+	}
+	return line
+}
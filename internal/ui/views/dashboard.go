@@ -8,12 +8,17 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/willibrandon/steep/internal/alerts"
+	"github.com/willibrandon/steep/internal/cluster"
 	"github.com/willibrandon/steep/internal/db/models"
+	"github.com/willibrandon/steep/internal/jobs"
 	"github.com/willibrandon/steep/internal/logger"
 	"github.com/willibrandon/steep/internal/metrics"
+	"github.com/willibrandon/steep/internal/notify"
 	"github.com/willibrandon/steep/internal/storage/sqlite"
+	"github.com/willibrandon/steep/internal/tracing"
 	"github.com/willibrandon/steep/internal/ui"
 	"github.com/willibrandon/steep/internal/ui/components"
 	"github.com/willibrandon/steep/internal/ui/styles"
@@ -25,10 +30,15 @@ type DashboardView struct {
 	height int
 
 	// Components
-	metricsPanel     *components.MetricsPanel
-	timeSeriesPanel  *components.TimeSeriesPanel
-	heatmapPanel     *components.HeatmapPanel
-	alertPanel       *components.AlertPanel
+	metricsPanel         *components.MetricsPanel
+	timeSeriesPanel      *components.TimeSeriesPanel
+	heatmapPanel         *components.HeatmapPanel
+	alertPanel           *components.AlertPanel
+	jobsPanel            *components.JobsPanel
+	queryTracePanel      *components.QueryTracePanel
+	poolHealthPanel      *components.PoolHealthPanel
+	notificationPanel    *components.NotificationPanel
+	clusterOverviewPanel *components.ClusterOverviewPanel
 
 	// Metrics collector for graph data
 	metricsCollector *metrics.Collector
@@ -70,13 +80,18 @@ func NewDashboard() *DashboardView {
 	heatmapConfig.Title = "TPS Heatmap (7 days)"
 
 	return &DashboardView{
-		metricsPanel:    components.NewMetricsPanel(),
-		timeSeriesPanel: components.NewTimeSeriesPanel(),
-		heatmapPanel:    components.NewHeatmapPanel(heatmapConfig),
-		alertPanel:      components.NewAlertPanel(),
-		chartsVisible:   true,
-		heatmapVisible:  false, // Hidden by default
-		timeWindow:      metrics.TimeWindow1h,
+		metricsPanel:         components.NewMetricsPanel(),
+		timeSeriesPanel:      components.NewTimeSeriesPanel(),
+		heatmapPanel:         components.NewHeatmapPanel(heatmapConfig),
+		alertPanel:           components.NewAlertPanel(),
+		jobsPanel:            components.NewJobsPanel(),
+		queryTracePanel:      components.NewQueryTracePanel(),
+		poolHealthPanel:      components.NewPoolHealthPanel(),
+		notificationPanel:    components.NewNotificationPanel(),
+		clusterOverviewPanel: components.NewClusterOverviewPanel(),
+		chartsVisible:        true,
+		heatmapVisible:       false, // Hidden by default
+		timeWindow:           metrics.TimeWindow1h,
 	}
 }
 
@@ -195,11 +210,53 @@ func (d *DashboardView) handleKeyPress(msg tea.KeyMsg) (ViewModel, tea.Cmd) {
 		if d.heatmapVisible {
 			d.updateHeatmapData()
 		}
+
+	// Cycle the channel filter on the Notifications panel
+	case "N":
+		d.notificationPanel.CycleFilter()
+
+	// Export recently traced queries (Live Queries panel)
+	case "e":
+		if d.queryTracePanel.HasTraces() {
+			return d, d.exportQueryTraces(tracing.ExportFormatCSV)
+		}
+	case "E":
+		if d.queryTracePanel.HasTraces() {
+			return d, d.exportQueryTraces(tracing.ExportFormatJSON)
+		}
 	}
 
 	return d, nil
 }
 
+// exportQueryTraces writes the traces currently held by the Live Queries
+// panel to a timestamped file under ~/.steep/exports in the given format.
+func (d *DashboardView) exportQueryTraces(format tracing.ExportFormat) tea.Cmd {
+	traces := d.queryTracePanel.Recent()
+	return func() tea.Msg {
+		ext := "csv"
+		if format == tracing.ExportFormatJSON {
+			ext = "json"
+		}
+		filename := fmt.Sprintf("~/.steep/exports/queries-%s.%s", time.Now().Format("20060102-150405"), ext)
+
+		var result *tracing.ExportResult
+		var err error
+		if format == tracing.ExportFormatJSON {
+			result, err = tracing.ExportJSON(traces, filename)
+		} else {
+			result, err = tracing.ExportCSV(traces, filename)
+		}
+		if err != nil {
+			logger.Warn("failed to export query traces", "error", err)
+			return nil
+		}
+
+		logger.Info("exported query traces", "path", result.FilePath, "rows", result.RowCount)
+		return nil
+	}
+}
+
 // cycleTimeWindow cycles through time windows.
 func (d *DashboardView) cycleTimeWindow(forward bool) {
 	windows := []metrics.TimeWindow{
@@ -589,9 +646,45 @@ func (d *DashboardView) renderMain() string {
 		heatmapHeight = d.heatmapPanel.Height()
 	}
 
+	// Calculate jobs panel height if there is anything to show
+	jobsPanelHeight := 0
+	if d.jobsPanel.HasJobs() {
+		d.jobsPanel.SetWidth(d.width - 2)
+		jobsPanelHeight = d.jobsPanel.Height()
+	}
+
+	// Calculate query trace panel height if there is anything to show
+	queryTracePanelHeight := 0
+	if d.queryTracePanel.HasTraces() {
+		d.queryTracePanel.SetWidth(d.width - 2)
+		queryTracePanelHeight = d.queryTracePanel.Height()
+	}
+
+	// Calculate pool health panel height if there is anything to show
+	poolHealthPanelHeight := 0
+	if d.poolHealthPanel.HasStats() {
+		d.poolHealthPanel.SetWidth(d.width - 2)
+		poolHealthPanelHeight = d.poolHealthPanel.Height()
+	}
+
+	// Calculate notification panel height if there is anything to show
+	notificationPanelHeight := 0
+	if d.notificationPanel.HasNotifications() {
+		d.notificationPanel.SetWidth(d.width - 2)
+		notificationPanelHeight = d.notificationPanel.Height()
+	}
+
+	// Calculate cluster overview panel height if there is more than one
+	// registered instance to show
+	clusterOverviewPanelHeight := 0
+	if d.clusterOverviewPanel.HasResults() {
+		d.clusterOverviewPanel.SetWidth(d.width - 2)
+		clusterOverviewPanelHeight = d.clusterOverviewPanel.Height()
+	}
+
 	// Calculate remaining height for charts or placeholder
 	footerHeight := lipgloss.Height(footer)
-	chrome := lipgloss.Height(statusBar) + lipgloss.Height(metricsPanel) + footerHeight + heatmapHeight + alertPanelHeight
+	chrome := lipgloss.Height(statusBar) + lipgloss.Height(metricsPanel) + footerHeight + heatmapHeight + alertPanelHeight + jobsPanelHeight + queryTracePanelHeight + poolHealthPanelHeight + notificationPanelHeight + clusterOverviewPanelHeight
 	contentHeight := max(minPlaceholderHeight, d.height-chrome)
 
 	var content string
@@ -612,6 +705,32 @@ func (d *DashboardView) renderMain() string {
 		sections = append(sections, d.alertPanel.View())
 	}
 
+	// Add jobs panel if any maintenance jobs have run recently
+	if d.jobsPanel.HasJobs() {
+		sections = append(sections, d.jobsPanel.View())
+	}
+
+	// Add query trace panel if tracing is enabled and has recorded anything
+	if d.queryTracePanel.HasTraces() {
+		sections = append(sections, d.queryTracePanel.View())
+	}
+
+	// Add pool health panel once the first stats poll has come in
+	if d.poolHealthPanel.HasStats() {
+		sections = append(sections, d.poolHealthPanel.View())
+	}
+
+	// Add notification panel once the LISTEN/NOTIFY bridge has delivered
+	// something
+	if d.notificationPanel.HasNotifications() {
+		sections = append(sections, d.notificationPanel.View())
+	}
+
+	// Add cluster overview panel once more than one instance is registered
+	if d.clusterOverviewPanel.HasResults() {
+		sections = append(sections, d.clusterOverviewPanel.View())
+	}
+
 	sections = append(sections, content)
 
 	// Add heatmap if visible
@@ -738,8 +857,20 @@ func (d *DashboardView) renderFooter() string {
 		heatmapHint = "[H]Show Heatmap"
 	}
 
+	// Export hint, only shown once there are traces to export
+	exportHint := ""
+	if d.queryTracePanel.HasTraces() {
+		exportHint = " [e]ExportCSV [E]ExportJSON"
+	}
+
+	// Notification filter hint, only shown once something has been received
+	notifyHint := ""
+	if d.notificationPanel.HasNotifications() {
+		notifyHint = " [N]FilterChannel"
+	}
+
 	// Dashboard-specific hints
-	dashboardHints := windowHint + " " + heatmapHint + " [a]History [?]Help"
+	dashboardHints := windowHint + " " + heatmapHint + " [a]History" + exportHint + notifyHint + " [?]Help"
 
 	// Navigation hints
 	navHints := "[1]Dashboard [2]Activity [3]Queries [4]Locks [5]Tables [6]Replication [7]SQL [8]Config [9]Logs [0]Roles"
@@ -865,6 +996,37 @@ func (d *DashboardView) SetAlertEngine(engine *alerts.Engine) {
 	d.alertEngine = engine
 }
 
+// RecordJobEvent updates the jobs panel with a lifecycle event from the
+// background job queue (see internal/jobs).
+func (d *DashboardView) RecordJobEvent(ev jobs.Event) {
+	d.jobsPanel.Record(ev)
+}
+
+// RecordQueryTrace updates the "Live Queries" panel with a newly completed
+// pgx operation recorded by the query tracer (see internal/tracing).
+func (d *DashboardView) RecordQueryTrace(t tracing.Trace) {
+	d.queryTracePanel.Record(t)
+}
+
+// RecordPoolStats updates the "Pool Health" panel with a newly polled
+// pgxpool.Stat snapshot.
+func (d *DashboardView) RecordPoolStats(stat *pgxpool.Stat) {
+	d.poolHealthPanel.Record(stat)
+}
+
+// RecordNotification updates the "Notifications" panel with a newly
+// received Postgres NOTIFY (see internal/notify).
+func (d *DashboardView) RecordNotification(n notify.Notification) {
+	d.notificationPanel.Record(n)
+}
+
+// RecordClusterOverview updates the "Cluster Overview" panel with the
+// latest fan-out query results across the cluster registry (see
+// internal/cluster).
+func (d *DashboardView) RecordClusterOverview(results map[string]cluster.RowsOrErr) {
+	d.clusterOverviewPanel.Record(results)
+}
+
 // SetInstanceFilter sets the instance filter and refreshes chart data (T054).
 func (d *DashboardView) SetInstanceFilter(instance string) {
 	d.instanceFilter = instance
@@ -908,101 +908,8 @@ func (s *ParallelTestSuite) TestParallel_PG18StreamingParallel() {
 // =============================================================================
 // Edge Cases and Error Handling
 // =============================================================================
-
-// TestParallel_WorkerPoolCancellation tests that worker pool handles cancellation correctly.
-func (s *ParallelTestSuite) TestParallel_WorkerPoolCancellation() {
-	env := s.env
-
-	// Create a cancellable context
-	ctx, cancel := context.WithCancel(s.ctx)
-
-	// Create test tables
-	tables := []replinit.TableInfo{
-		{SchemaName: "public", TableName: "cancel_test_1", FullName: "public.cancel_test_1", SizeBytes: 1000},
-		{SchemaName: "public", TableName: "cancel_test_2", FullName: "public.cancel_test_2", SizeBytes: 1000},
-		{SchemaName: "public", TableName: "cancel_test_3", FullName: "public.cancel_test_3", SizeBytes: 1000},
-	}
-
-	logger := replinit.NewLogger(slog.Default())
-	copier := replinit.NewParallelTableCopier(env.sourcePool, 2, logger)
-
-	// Cancel after a short delay
-	go func() {
-		time.Sleep(50 * time.Millisecond)
-		cancel()
-	}()
-
-	// Copy should handle cancellation gracefully
-	connStr := fmt.Sprintf("host=%s port=%d dbname=testdb user=test password=test",
-		env.sourceHostExternal, env.sourcePortExternal)
-	results, err := copier.CopyTables(ctx, tables, connStr)
-
-	// Should either complete or return context error
-	if err != nil {
-		s.Assert().ErrorIs(err, context.Canceled, "Should return context canceled error")
-	}
-
-	s.T().Logf("Cancellation test completed with %d results", len(results))
-}
-
-// TestParallel_EmptyTableList tests worker pool with empty table list.
-func (s *ParallelTestSuite) TestParallel_EmptyTableList() {
-	env := s.env
-
-	logger := replinit.NewLogger(slog.Default())
-	copier := replinit.NewParallelTableCopier(env.sourcePool, 4, logger)
-
-	connStr := fmt.Sprintf("host=%s port=%d dbname=testdb user=test password=test",
-		env.sourceHostExternal, env.sourcePortExternal)
-	results, err := copier.CopyTables(s.ctx, []replinit.TableInfo{}, connStr)
-
-	s.Require().NoError(err, "Empty table list should not error")
-	s.Assert().Len(results, 0, "Should return empty results")
-}
-
-// TestParallel_SingleTableWithMaxWorkers tests single table with maximum workers.
-func (s *ParallelTestSuite) TestParallel_SingleTableWithMaxWorkers() {
-	ctx := s.ctx
-	env := s.env
-
-	// Create single table
-	_, err := env.sourcePool.Exec(ctx, `
-		CREATE TABLE parallel_single (
-			id SERIAL PRIMARY KEY,
-			data TEXT
-		)
-	`)
-	s.Require().NoError(err)
-
-	_, err = env.sourcePool.Exec(ctx, `
-		INSERT INTO parallel_single (data) SELECT 'row_' || i FROM generate_series(1, 50) AS i
-	`)
-	s.Require().NoError(err)
-
-	// Get size
-	var sizeBytes int64
-	err = env.sourcePool.QueryRow(ctx, "SELECT pg_total_relation_size('parallel_single')").Scan(&sizeBytes)
-	s.Require().NoError(err)
-
-	tables := []replinit.TableInfo{
-		{SchemaName: "public", TableName: "parallel_single", FullName: "public.parallel_single", SizeBytes: sizeBytes},
-	}
-
-	// Use 16 workers for 1 table (should work fine, extra workers just won't be used)
-	logger := replinit.NewLogger(slog.Default())
-	copier := replinit.NewParallelTableCopier(env.sourcePool, 16, logger)
-
-	var progressCalls int32
-	copier.SetProgressCallback(func(completed, total int, currentTable string, percent float64) {
-		atomic.AddInt32(&progressCalls, 1)
-	})
-
-	connStr := fmt.Sprintf("host=%s port=%d dbname=testdb user=test password=test",
-		env.sourceHostExternal, env.sourcePortExternal)
-	results, err := copier.CopyTables(ctx, tables, connStr)
-
-	s.Require().NoError(err)
-	s.Assert().Len(results, 1)
-	s.Assert().NoError(results[0].Error)
-	s.Assert().Equal("public.parallel_single", results[0].TableInfo.FullName)
-}
+//
+// TestParallel_WorkerPoolCancellation, TestParallel_EmptyTableList, and
+// TestParallel_SingleTableWithMaxWorkers were moved to
+// tests/unit/repl/init/parallel_test.go, where they run against
+// replinittest's in-memory fake instead of these Docker containers.
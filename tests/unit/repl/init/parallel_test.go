@@ -0,0 +1,126 @@
+package init_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	replinit "github.com/willibrandon/steep/internal/repl/init"
+	"github.com/willibrandon/steep/tests/unit/repl/init/replinittest"
+)
+
+// =============================================================================
+// ParallelTableCopier Tests (replinittest fake, no Docker required)
+// =============================================================================
+
+// TestParallel_EmptyTableList tests worker pool with empty table list.
+func TestParallel_EmptyTableList(t *testing.T) {
+	source := replinittest.NewFakeSource(t)
+	logger := replinit.NewLogger(slog.Default())
+	copier := replinit.NewParallelTableCopier(nil, 4, logger, replinit.WithCopyFunc(source.CopyTableFunc()))
+
+	results, err := copier.CopyTables(context.Background(), []replinit.TableInfo{}, "")
+
+	if err != nil {
+		t.Fatalf("empty table list should not error: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected no results, got %d", len(results))
+	}
+}
+
+// TestParallel_SingleTableWithMaxWorkers tests single table with maximum workers.
+func TestParallel_SingleTableWithMaxWorkers(t *testing.T) {
+	source := replinittest.NewFakeSource(t)
+	source.EnqueueCopyResult("public.parallel_single", 50)
+
+	tables := []replinit.TableInfo{
+		{SchemaName: "public", TableName: "parallel_single", FullName: "public.parallel_single", SizeBytes: 4096},
+	}
+
+	logger := replinit.NewLogger(slog.Default())
+	// 16 workers for 1 table should work fine; extra workers just won't be used.
+	copier := replinit.NewParallelTableCopier(nil, 16, logger, replinit.WithCopyFunc(source.CopyTableFunc()))
+
+	var progressCalls int32
+	copier.SetProgressCallback(func(completed, total int, currentTable string, percent float64) {
+		atomic.AddInt32(&progressCalls, 1)
+	})
+
+	results, err := copier.CopyTables(context.Background(), tables, "")
+	if err != nil {
+		t.Fatalf("CopyTables returned error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		t.Fatalf("unexpected per-table error: %v", results[0].Error)
+	}
+	if results[0].TableInfo.FullName != "public.parallel_single" {
+		t.Fatalf("unexpected table in result: %s", results[0].TableInfo.FullName)
+	}
+	if results[0].RowsCopied != 50 {
+		t.Fatalf("expected 50 rows copied, got %d", results[0].RowsCopied)
+	}
+	if atomic.LoadInt32(&progressCalls) == 0 {
+		t.Fatal("expected at least one progress callback")
+	}
+}
+
+// TestParallel_WorkerPoolCancellation tests that worker pool handles cancellation correctly.
+func TestParallel_WorkerPoolCancellation(t *testing.T) {
+	source := replinittest.NewFakeSource(t)
+	tables := []replinit.TableInfo{
+		{SchemaName: "public", TableName: "cancel_test_1", FullName: "public.cancel_test_1", SizeBytes: 1000},
+		{SchemaName: "public", TableName: "cancel_test_2", FullName: "public.cancel_test_2", SizeBytes: 1000},
+		{SchemaName: "public", TableName: "cancel_test_3", FullName: "public.cancel_test_3", SizeBytes: 1000},
+	}
+	// Slow each table down so the cancellation below actually lands mid-copy.
+	for _, tbl := range tables {
+		source.InjectLatency(tbl.FullName, time.Second)
+	}
+
+	logger := replinit.NewLogger(slog.Default())
+	copier := replinit.NewParallelTableCopier(nil, 2, logger, replinit.WithCopyFunc(source.CopyTableFunc()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		source.WaitForCopyStart("public.cancel_test_1", time.Second)
+		cancel()
+	}()
+
+	results, err := copier.CopyTables(ctx, tables, "")
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled or nil, got: %v", err)
+	}
+	t.Logf("cancellation test completed with %d results", len(results))
+}
+
+// TestParallel_TableCopyError verifies a single failing table surfaces its
+// error without preventing the other tables from completing.
+func TestParallel_TableCopyError(t *testing.T) {
+	source := replinittest.NewFakeSource(t)
+	source.EnqueueCopyResult("public.ok", 10)
+	source.InjectError("public.broken", errors.New("connection reset mid-COPY"))
+
+	tables := []replinit.TableInfo{
+		{SchemaName: "public", TableName: "ok", FullName: "public.ok", SizeBytes: 100},
+		{SchemaName: "public", TableName: "broken", FullName: "public.broken", SizeBytes: 100},
+	}
+
+	logger := replinit.NewLogger(slog.Default())
+	copier := replinit.NewParallelTableCopier(nil, 2, logger, replinit.WithCopyFunc(source.CopyTableFunc()))
+
+	results, err := copier.CopyTables(context.Background(), tables, "")
+	if err == nil {
+		t.Fatal("expected CopyTables to surface the injected error")
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected results for both tables, got %d", len(results))
+	}
+}
@@ -0,0 +1,158 @@
+// Package replinittest provides an in-memory fake of a source PostgreSQL
+// cluster's COPY behavior, so ParallelTableCopier's unit tests can run
+// without a real PostgreSQL cluster.
+//
+// It does not speak actual pgwire on the network; instead it is wired in
+// through the same injectable seam the production code already exposes
+// (replinit.WithCopyFunc), and records what was asked of it so tests can
+// make assertions the way they would against a mock gRPC server: register
+// fixtures up front, run the code under test, then inspect what was
+// observed.
+//
+// Scope: this fake only injects copy-level behavior (rows/bytes copied,
+// errors, and latency per table). It does not answer catalog queries
+// (pg_class/pg_namespace/pg_publication) or speak the logical-replication
+// slot protocol (CREATE_REPLICATION_SLOT/START_REPLICATION), so failure
+// modes that depend on those — publication ownership changes, a dropped
+// connection mid-COPY at the protocol level — aren't exercised here, and
+// the snapshot init orchestrator that drives catalog/replication setup is
+// untested by this package.
+package replinittest
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	replinit "github.com/willibrandon/steep/internal/repl/init"
+)
+
+// FakeSource is an in-memory stand-in for a source PostgreSQL cluster.
+// It is safe for concurrent use by multiple workers, matching how
+// ParallelTableCopier drives it.
+type FakeSource struct {
+	t *testing.T
+
+	mu      sync.Mutex
+	rows    map[string]int64         // table -> row count to report on copy
+	bytes   map[string]int64         // table -> byte count to report on copy
+	errs    map[string]error         // table -> error to return instead of copying
+	latency map[string]time.Duration // table -> artificial delay before completing
+	started map[string]chan struct{} // table -> closed once a copy begins
+}
+
+// NewFakeSource creates a fake source cluster scoped to the lifetime of t.
+func NewFakeSource(t *testing.T) *FakeSource {
+	return &FakeSource{
+		t:       t,
+		rows:    make(map[string]int64),
+		bytes:   make(map[string]int64),
+		errs:    make(map[string]error),
+		latency: make(map[string]time.Duration),
+		started: make(map[string]chan struct{}),
+	}
+}
+
+// EnqueueCopyResult configures the fake to report rows/bytes for a
+// subsequent COPY of table, as if `COPY table TO STDOUT` had streamed that
+// many rows.
+func (s *FakeSource) EnqueueCopyResult(table string, rows int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rows[table] = rows
+	s.bytes[table] = rows * 64 // approximate row width, good enough for progress math
+}
+
+// InjectError makes every subsequent copy of table fail with err instead of
+// succeeding, so tests can exercise retry and partial-failure paths.
+func (s *FakeSource) InjectError(table string, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs[table] = err
+}
+
+// InjectLatency adds an artificial delay before a copy of table completes,
+// used to exercise cancellation and slow-table handling deterministically.
+func (s *FakeSource) InjectLatency(table string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency[table] = d
+}
+
+// WaitForCopyStart blocks until a copy of table has started, or timeout
+// elapses. It returns false on timeout.
+func (s *FakeSource) WaitForCopyStart(table string, timeout time.Duration) bool {
+	ch := s.startChannel(table)
+	select {
+	case <-ch:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func (s *FakeSource) startChannel(table string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch, ok := s.started[table]
+	if !ok {
+		ch = make(chan struct{})
+		s.started[table] = ch
+	}
+	return ch
+}
+
+func (s *FakeSource) markStarted(table string) {
+	ch := s.startChannel(table)
+	select {
+	case <-ch:
+		// already started
+	default:
+		close(ch)
+	}
+}
+
+// CopyTableFunc returns a function suitable for replinit.WithCopyFunc that
+// replays the fixtures configured on s instead of touching a real cluster.
+func (s *FakeSource) CopyTableFunc() func(ctx context.Context, task replinit.TableCopyTask, workerID int) replinit.ParallelCopyResult {
+	return func(ctx context.Context, task replinit.TableCopyTask, workerID int) replinit.ParallelCopyResult {
+		table := task.Table.FullName
+		s.markStarted(table)
+
+		s.mu.Lock()
+		delay := s.latency[table]
+		err := s.errs[table]
+		rows := s.rows[table]
+		bytes := s.bytes[table]
+		s.mu.Unlock()
+
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return replinit.ParallelCopyResult{TableInfo: task.Table, Error: ctx.Err()}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return replinit.ParallelCopyResult{TableInfo: task.Table, Error: ctx.Err()}
+		default:
+		}
+
+		if err != nil {
+			return replinit.ParallelCopyResult{TableInfo: task.Table, Error: err}
+		}
+
+		if rows == 0 && bytes == 0 {
+			bytes = task.Table.SizeBytes
+		}
+
+		return replinit.ParallelCopyResult{
+			TableInfo:   task.Table,
+			RowsCopied:  rows,
+			BytesCopied: bytes,
+		}
+	}
+}